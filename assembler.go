@@ -0,0 +1,1663 @@
+package jwasm
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"slices"
+	"strings"
+)
+
+// Assembler builds modules from the WebAssembly text format. The result is the module that
+// the binary Parser decodes from the output of standard tools for the same text.
+// https://webassembly.github.io/spec/core/text/index.html
+type Assembler struct {
+}
+
+// Assemble reads a module in the text format from r. The text is either a single module or,
+// as an abbreviation, the sequence of its fields. Errors are returned as *SyntaxError.
+func (a *Assembler) Assemble(r io.Reader) (*Module, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading module text failed: %w", err)
+	}
+
+	exprs, err := parseSExpressions(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(exprs) == 1 && exprs[0].isList("module") {
+		return assembleModule(exprs[0])
+	}
+	return assembleModule(inlineModule(exprs))
+}
+
+// inlineModule returns a module list of the module fields in fields.
+// https://webassembly.github.io/spec/core/text/modules.html#text-module
+//
+// A module consisting only of fields may omit the module keyword and parentheses
+func inlineModule(fields []*sexpr) *sexpr {
+	module := &sexpr{token: token{tokenLeftParen, "(", position{1, 1}}, list: true}
+	module.children = append([]*sexpr{{token: token{tokenKeyword, "module", position{1, 1}}}}, fields...)
+	return module
+}
+
+// indexSpace binds identifiers to the indices of one kind of definition.
+// https://webassembly.github.io/spec/core/text/modules.html#indices
+type indexSpace struct {
+	kind string
+	ids  map[string]uint32
+	n    uint32
+}
+
+func newIndexSpace(kind string) indexSpace {
+	return indexSpace{kind: kind, ids: make(map[string]uint32)}
+}
+
+// bind consumes an optional identifier and binds it to the next index of space.
+func (c *cursor) bind(space *indexSpace) (uint32, error) {
+	pos := c.position()
+	return space.bindAt(c.id(), pos)
+}
+
+func (s *indexSpace) bindAt(id string, pos position) (uint32, error) {
+	if id != "" {
+		if _, ok := s.ids[id]; ok {
+			return 0, syntaxErrorf(pos, ErrDuplicateIdentifier, "%s %s", s.kind, id)
+		}
+		s.ids[id] = s.n
+	}
+
+	s.n++
+	return s.n - 1, nil
+}
+
+// peekIndex returns true if the next item is a numeric or symbolic index.
+func (c *cursor) peekIndex() bool {
+	return c.peekKind(tokenNumber) || c.peekKind(tokenId)
+}
+
+// index consumes a numeric index or an identifier bound in space.
+func (c *cursor) index(space *indexSpace) (uint32, error) {
+	if c.peekKind(tokenId) {
+		id := c.peek().token.text
+		idx, ok := space.ids[id]
+		if !ok {
+			return 0, c.errorf(ErrUnknownIdentifier, "%s %s", space.kind, id)
+		}
+		c.next()
+		return idx, nil
+	}
+
+	if !c.peekKind(tokenNumber) {
+		return 0, c.unexpected(space.kind + " index")
+	}
+	return c.uint32()
+}
+
+// listCursor consumes a list starting with the keyword kw and returns a cursor over its
+// remaining children.
+func (c *cursor) listCursor(kw string) (*cursor, error) {
+	if !c.peekList(kw) {
+		return nil, c.unexpected("(" + kw + " ...)")
+	}
+	return newCursor(c.next()), nil
+}
+
+type moduleAssembler struct {
+	types     []FunctionType
+	typeIds   indexSpace
+	functions indexSpace
+	tables    indexSpace
+	memories  indexSpace
+	globals   indexSpace
+	elements  indexSpace
+	datas     indexSpace
+
+	// indices holds the index bound to each definition in the first pass
+	indices map[*sexpr]uint32
+	// defined is the index space of the first function, table, memory or global that is
+	// defined, imports must precede all definitions
+	defined *indexSpace
+
+	imports         []importEntry
+	typeIndices     []uint32
+	code            []functionCode
+	tableTypes      []TableType
+	memoryTypes     []MemoryType
+	globalEntries   []global
+	exports         []export
+	start           *StartSection
+	elementSegments []element
+	dataSegments    []data
+	// usesDataCount is set if an instruction refers to data segments, the binary format then
+	// requires a data count section
+	usesDataCount bool
+}
+
+func assembleModule(module *sexpr) (*Module, error) {
+	// https://webassembly.github.io/spec/core/text/modules.html#modules
+	a := &moduleAssembler{
+		typeIds:   newIndexSpace("type"),
+		functions: newIndexSpace("function"),
+		tables:    newIndexSpace("table"),
+		memories:  newIndexSpace("memory"),
+		globals:   newIndexSpace("global"),
+		elements:  newIndexSpace("elem"),
+		datas:     newIndexSpace("data"),
+		indices:   make(map[*sexpr]uint32),
+	}
+
+	c := newCursor(module)
+	c.id()
+
+	var fields []*sexpr
+	for !c.done() {
+		field := c.next()
+		if !field.list || len(field.children) == 0 || field.children[0].list {
+			c.i--
+			return nil, c.unexpected("module field")
+		}
+		fields = append(fields, field)
+	}
+
+	// Identifiers may be used before their definition, so they are bound in a first pass
+	for _, field := range fields {
+		err := a.bindField(field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, field := range fields {
+		err := a.assembleField(field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.module(), nil
+}
+
+func (a *moduleAssembler) module() *Module {
+	m := new(Module)
+	if len(a.types) > 0 {
+		m.addSection(&TypeSection{a.types})
+	}
+	if len(a.imports) > 0 {
+		m.addSection(&ImportSection{a.imports})
+	}
+	if len(a.typeIndices) > 0 {
+		m.addSection(&FunctionSection{a.typeIndices})
+	}
+	if len(a.tableTypes) > 0 {
+		m.addSection(&TableSection{a.tableTypes})
+	}
+	if len(a.memoryTypes) > 0 {
+		m.addSection(&MemorySection{a.memoryTypes})
+	}
+	if len(a.globalEntries) > 0 {
+		m.addSection(&GlobalSection{a.globalEntries})
+	}
+	if len(a.exports) > 0 {
+		m.addSection(&ExportSection{a.exports})
+	}
+	if a.start != nil {
+		m.addSection(a.start)
+	}
+	if len(a.elementSegments) > 0 {
+		m.addSection(&ElementSection{a.elementSegments})
+	}
+	if a.usesDataCount {
+		m.addSection(&DataCountSection{uint32(len(a.dataSegments))})
+	}
+	if len(a.code) > 0 {
+		m.addSection(&CodeSection{a.code})
+	}
+	if len(a.dataSegments) > 0 {
+		m.addSection(&DataSection{a.dataSegments})
+	}
+	return m
+}
+
+// Binding
+
+func (a *moduleAssembler) bindField(field *sexpr) error {
+	c := newCursor(field)
+
+	switch field.children[0].token.text {
+	case "type":
+		// https://webassembly.github.io/spec/core/text/modules.html#types
+		_, err := c.bind(&a.typeIds)
+		if err != nil {
+			return err
+		}
+
+		fc, err := c.listCursor("func")
+		if err != nil {
+			return err
+		}
+
+		functionType, _, err := a.functionType(fc)
+		if err != nil {
+			return err
+		}
+
+		a.types = append(a.types, functionType)
+		return c.end()
+	case "import":
+		if a.defined != nil {
+			return c.errorf(ErrUnexpectedToken, "import after %s", a.defined.kind)
+		}
+
+		for i := 0; i < 2; i++ {
+			if _, err := c.string(); err != nil {
+				return err
+			}
+		}
+
+		desc := c.next()
+		if desc == nil || !desc.list || len(desc.children) == 0 {
+			c.i--
+			return c.unexpected("import description")
+		}
+
+		space := a.space(desc.children[0].token.text)
+		if space == nil {
+			return c.errorf(ErrUnexpectedToken, "unknown import description [%s]", desc)
+		}
+
+		idx, err := newCursor(desc).bind(space)
+		a.indices[field] = idx
+		return err
+	case "func", "table", "memory", "global":
+		space := a.space(field.children[0].token.text)
+		idx, err := c.bind(space)
+		if err != nil {
+			return err
+		}
+		a.indices[field] = idx
+
+		for c.peekList("export") {
+			c.next()
+		}
+
+		if c.peekList("import") {
+			if a.defined != nil {
+				return c.errorf(ErrUnexpectedToken, "import after %s", a.defined.kind)
+			}
+			return nil
+		}
+		if a.defined == nil {
+			a.defined = space
+		}
+
+		// Inline element and data segments take the next anonymous segment index
+		if space == &a.tables && c.peekKind(tokenKeyword) {
+			c.next()
+			if c.peekList("elem") {
+				a.elements.n++
+			}
+		}
+		if space == &a.memories && c.peekList("data") {
+			a.datas.n++
+		}
+		return nil
+	case "elem":
+		idx, err := c.bind(&a.elements)
+		a.indices[field] = idx
+		return err
+	case "data":
+		idx, err := c.bind(&a.datas)
+		a.indices[field] = idx
+		return err
+	case "export", "start":
+		return nil
+	default:
+		return syntaxErrorf(field.token.pos, ErrUnexpectedToken, "unknown module field [%s]", field)
+	}
+}
+
+// space returns the index space of an import or export kind.
+func (a *moduleAssembler) space(kind string) *indexSpace {
+	switch kind {
+	case "func":
+		return &a.functions
+	case "table":
+		return &a.tables
+	case "memory":
+		return &a.memories
+	case "global":
+		return &a.globals
+	default:
+		return nil
+	}
+}
+
+// Fields
+
+func (a *moduleAssembler) assembleField(field *sexpr) error {
+	c := newCursor(field)
+	idx := a.indices[field]
+
+	switch field.children[0].token.text {
+	case "import":
+		return a.assembleImport(c)
+	case "func":
+		return a.assembleFunction(c, idx)
+	case "table":
+		return a.assembleTable(c, idx)
+	case "memory":
+		return a.assembleMemory(c, idx)
+	case "global":
+		return a.assembleGlobal(c, idx)
+	case "export":
+		// https://webassembly.github.io/spec/core/text/modules.html#exports
+		name, err := c.name()
+		if err != nil {
+			return err
+		}
+
+		dc := c.next()
+		if dc == nil || !dc.list || len(dc.children) == 0 || a.space(dc.children[0].token.text) == nil {
+			c.i--
+			return c.unexpected("export description")
+		}
+
+		kind := dc.children[0].token.text
+		desc := newCursor(dc)
+		x, err := desc.index(a.space(kind))
+		if err != nil {
+			return err
+		}
+
+		a.exports = append(a.exports, export{name, exportDescriptionOf(kind, x)})
+		if err := desc.end(); err != nil {
+			return err
+		}
+		return c.end()
+	case "start":
+		// https://webassembly.github.io/spec/core/text/modules.html#start-function
+		if a.start != nil {
+			return syntaxErrorf(field.token.pos, ErrUnexpectedToken, "multiple start sections")
+		}
+
+		x, err := c.index(&a.functions)
+		if err != nil {
+			return err
+		}
+
+		a.start = &StartSection{functionIndex(x)}
+		return c.end()
+	case "elem":
+		return a.assembleElement(c)
+	case "data":
+		return a.assembleData(c)
+	default:
+		return nil
+	}
+}
+
+func exportDescriptionOf(kind string, x uint32) exportDescription {
+	switch kind {
+	case "func":
+		return &exportDescriptionFunc{functionIndex(x)}
+	case "table":
+		return &exportDescriptionTable{tableIndex(x)}
+	case "memory":
+		return &exportDescriptionMem{memoryIndex(x)}
+	default:
+		return &exportDescriptionGlobal{globalIndex(x)}
+	}
+}
+
+// inlineExports consumes the abbreviated exports of a definition.
+func (a *moduleAssembler) inlineExports(c *cursor, kind string, idx uint32) error {
+	for c.peekList("export") {
+		ec := newCursor(c.next())
+		name, err := ec.name()
+		if err != nil {
+			return err
+		}
+
+		a.exports = append(a.exports, export{name, exportDescriptionOf(kind, idx)})
+		if err := ec.end(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inlineImport consumes the abbreviated import of a definition, if there is one.
+func (a *moduleAssembler) inlineImport(c *cursor) (string, string, bool, error) {
+	if !c.peekList("import") {
+		return "", "", false, nil
+	}
+
+	ic := newCursor(c.next())
+	module, err := ic.name()
+	if err != nil {
+		return "", "", false, err
+	}
+
+	name, err := ic.name()
+	if err != nil {
+		return "", "", false, err
+	}
+
+	return module, name, true, ic.end()
+}
+
+func (a *moduleAssembler) assembleImport(c *cursor) error {
+	// https://webassembly.github.io/spec/core/text/modules.html#imports
+	module, err := c.name()
+	if err != nil {
+		return err
+	}
+
+	name, err := c.name()
+	if err != nil {
+		return err
+	}
+
+	descList := c.next()
+	desc := newCursor(descList)
+	desc.id()
+
+	var description importDescription
+	switch descList.children[0].token.text {
+	case "func":
+		typeIdx, _, _, err := a.typeUse(desc)
+		if err != nil {
+			return err
+		}
+		description = &importDescriptionFunc{typeIdx}
+	case "table":
+		tableType, err := a.tableType(desc)
+		if err != nil {
+			return err
+		}
+		description = &importDescriptionTable{tableType}
+	case "memory":
+		limits, err := a.limits(desc)
+		if err != nil {
+			return err
+		}
+		description = &importDescriptionMem{MemoryType{limits}}
+	case "global":
+		globalType, err := a.globalType(desc)
+		if err != nil {
+			return err
+		}
+		description = &importDescriptionGlobal{globalType}
+	}
+
+	if err := desc.end(); err != nil {
+		return err
+	}
+
+	a.imports = append(a.imports, importEntry{module, name, description})
+	return c.end()
+}
+
+func (a *moduleAssembler) assembleFunction(c *cursor, idx uint32) error {
+	// https://webassembly.github.io/spec/core/text/modules.html#functions
+	c.id()
+	err := a.inlineExports(c, "func", idx)
+	if err != nil {
+		return err
+	}
+
+	module, name, imported, err := a.inlineImport(c)
+	if err != nil {
+		return err
+	}
+
+	pos := c.position()
+	typeIdx, functionType, parameterIds, err := a.typeUse(c)
+	if err != nil {
+		return err
+	}
+
+	if imported {
+		a.imports = append(a.imports, importEntry{module, name, &importDescriptionFunc{typeIdx}})
+		return c.end()
+	}
+
+	scope := newFunctionScope()
+	for i := range functionType.ParameterTypes {
+		var id string
+		if i < len(parameterIds) {
+			id = parameterIds[i]
+		}
+
+		if _, err := scope.locals.bindAt(id, pos); err != nil {
+			return err
+		}
+	}
+
+	var runs []locals
+	for c.peekList("local") {
+		lc := newCursor(c.next())
+		if lc.peekKind(tokenId) {
+			if _, err := lc.bind(&scope.locals); err != nil {
+				return err
+			}
+
+			valueType, err := a.valueType(lc)
+			if err != nil {
+				return err
+			}
+			runs = appendLocal(runs, valueType)
+
+			if err := lc.end(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for !lc.done() {
+			valueType, err := a.valueType(lc)
+			if err != nil {
+				return err
+			}
+			scope.locals.bindAt("", pos)
+			runs = appendLocal(runs, valueType)
+		}
+	}
+
+	body, err := a.instructions(c, scope)
+	if err != nil {
+		return err
+	}
+
+	if err := c.end(); err != nil {
+		return err
+	}
+
+	a.typeIndices = append(a.typeIndices, uint32(typeIdx))
+	a.code = append(a.code, functionCode{runs, body})
+	return nil
+}
+
+// appendLocal adds a local to the declarations, consecutive locals of the same type are
+// compressed into one run.
+func appendLocal(runs []locals, valueType ValueType) []locals {
+	if len(runs) > 0 && runs[len(runs)-1].valueType == valueType {
+		runs[len(runs)-1].n++
+		return runs
+	}
+	return append(runs, locals{1, valueType})
+}
+
+func (a *moduleAssembler) assembleTable(c *cursor, idx uint32) error {
+	// https://webassembly.github.io/spec/core/text/modules.html#tables
+	c.id()
+	err := a.inlineExports(c, "table", idx)
+	if err != nil {
+		return err
+	}
+
+	module, name, imported, err := a.inlineImport(c)
+	if err != nil {
+		return err
+	}
+
+	if imported {
+		tableType, err := a.tableType(c)
+		if err != nil {
+			return err
+		}
+
+		a.imports = append(a.imports, importEntry{module, name, &importDescriptionTable{tableType}})
+		return c.end()
+	}
+
+	if !c.peekKind(tokenKeyword) {
+		tableType, err := a.tableType(c)
+		if err != nil {
+			return err
+		}
+
+		a.tableTypes = append(a.tableTypes, tableType)
+		return c.end()
+	}
+
+	// An inline element segment defines a table of its exact size, initialized at offset 0
+	elementType, err := a.referenceType(c)
+	if err != nil {
+		return err
+	}
+
+	ec, err := c.listCursor("elem")
+	if err != nil {
+		return err
+	}
+
+	// Function indices abbreviate a list of ref.func expressions
+	if ec.peekIndex() {
+		elementType = nil
+	}
+
+	element, err := a.elementList(ec, elementType)
+	if err != nil {
+		return err
+	}
+
+	n := uint32(max(len(element.functionIndices), len(element.init)))
+	element.mode = &elementModeActive{tableIndex(idx), []instruction{&int32Const{0}}}
+	a.tableTypes = append(a.tableTypes, TableType{element.elementType, Limits{n, &n}})
+	a.elementSegments = append(a.elementSegments, element)
+	return c.end()
+}
+
+func (a *moduleAssembler) assembleMemory(c *cursor, idx uint32) error {
+	// https://webassembly.github.io/spec/core/text/modules.html#memories
+	c.id()
+	err := a.inlineExports(c, "memory", idx)
+	if err != nil {
+		return err
+	}
+
+	module, name, imported, err := a.inlineImport(c)
+	if err != nil {
+		return err
+	}
+
+	if c.peekList("data") && !imported {
+		// An inline data segment defines a memory of the pages it needs, initialized at offset 0
+		dc := newCursor(c.next())
+		init := []byte{}
+		for !dc.done() {
+			s, err := dc.string()
+			if err != nil {
+				return err
+			}
+			init = append(init, s...)
+		}
+
+		n := uint32((len(init) + 0xFFFF) / 0x10000)
+		a.memoryTypes = append(a.memoryTypes, MemoryType{Limits{n, &n}})
+		a.dataSegments = append(a.dataSegments, data{init, &dataModeActive{memoryIndex(idx), []instruction{&int32Const{0}}}})
+		return c.end()
+	}
+
+	limits, err := a.limits(c)
+	if err != nil {
+		return err
+	}
+
+	if imported {
+		a.imports = append(a.imports, importEntry{module, name, &importDescriptionMem{MemoryType{limits}}})
+	} else {
+		a.memoryTypes = append(a.memoryTypes, MemoryType{limits})
+	}
+	return c.end()
+}
+
+func (a *moduleAssembler) assembleGlobal(c *cursor, idx uint32) error {
+	// https://webassembly.github.io/spec/core/text/modules.html#globals
+	c.id()
+	err := a.inlineExports(c, "global", idx)
+	if err != nil {
+		return err
+	}
+
+	module, name, imported, err := a.inlineImport(c)
+	if err != nil {
+		return err
+	}
+
+	globalType, err := a.globalType(c)
+	if err != nil {
+		return err
+	}
+
+	if imported {
+		a.imports = append(a.imports, importEntry{module, name, &importDescriptionGlobal{globalType}})
+		return c.end()
+	}
+
+	init, err := a.constantExpression(c)
+	if err != nil {
+		return err
+	}
+
+	a.globalEntries = append(a.globalEntries, global{globalType, init})
+	return nil
+}
+
+func (a *moduleAssembler) assembleElement(c *cursor) error {
+	// https://webassembly.github.io/spec/core/text/modules.html#element-segments
+	c.id()
+
+	var mode elementMode = &elementModePassive{}
+	var table tableIndex
+	hasTable := false
+
+	if c.keyword("declare") {
+		mode = &elementModeDeclarative{}
+	} else {
+		if c.peekList("table") {
+			tc := newCursor(c.next())
+			x, err := tc.index(&a.tables)
+			if err != nil {
+				return err
+			}
+			if err := tc.end(); err != nil {
+				return err
+			}
+			table, hasTable = tableIndex(x), true
+		}
+
+		offset, ok, err := a.offset(c)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			mode = &elementModeActive{table, offset}
+		} else if hasTable {
+			return c.unexpected("offset")
+		}
+	}
+
+	var element element
+	var err error
+	switch {
+	case c.keyword("func"):
+		element, err = a.elementList(c, nil)
+	case c.peekKind(tokenKeyword):
+		elementType, typeErr := a.referenceType(c)
+		if typeErr != nil {
+			return typeErr
+		}
+		element, err = a.elementList(c, elementType)
+	default:
+		// Active segments of table 0 may omit the func keyword
+		if _, active := mode.(*elementModeActive); !active || hasTable {
+			return c.unexpected("element list")
+		}
+		element, err = a.elementList(c, nil)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	element.mode = mode
+	a.elementSegments = append(a.elementSegments, element)
+	return c.end()
+}
+
+// elementList consumes the initial values of an element segment, either function indices
+// if elementType is nil, or element expressions of the given type.
+func (a *moduleAssembler) elementList(c *cursor, elementType *referenceType) (element, error) {
+	if elementType == nil {
+		// Lists of function indices may be written with the func keyword as well
+		c.keyword("func")
+
+		result := element{elementType: ValueTypeFuncRef, functionIndices: []functionIndex{}}
+		for c.peekIndex() {
+			x, err := c.index(&a.functions)
+			if err != nil {
+				return element{}, err
+			}
+			result.functionIndices = append(result.functionIndices, functionIndex(x))
+		}
+		return result, c.end()
+	}
+
+	result := element{elementType: elementType, init: [][]instruction{}}
+	for !c.done() {
+		item := c.next()
+		if !item.list {
+			c.i--
+			return element{}, c.unexpected("element expression")
+		}
+
+		var expression []instruction
+		var err error
+		if item.isList("item") {
+			expression, err = a.constantExpression(newCursor(item))
+		} else {
+			expression, err = a.foldedInstruction(item, newFunctionScope())
+		}
+
+		if err != nil {
+			return element{}, a.wrapError(c, err)
+		}
+		result.init = append(result.init, expression)
+	}
+	return result, nil
+}
+
+// offset consumes the offset of an active segment, written either as (offset instr*) or
+// abbreviated as a single folded instruction.
+func (a *moduleAssembler) offset(c *cursor) ([]instruction, bool, error) {
+	if c.peekList("offset") {
+		expression, err := a.constantExpression(newCursor(c.next()))
+		return expression, true, err
+	}
+
+	item := c.peek()
+	if item == nil || !item.list || item.isList("item") {
+		return nil, false, nil
+	}
+
+	c.next()
+	expression, err := a.foldedInstruction(item, newFunctionScope())
+	if err != nil {
+		return nil, false, a.wrapError(c, err)
+	}
+	return expression, true, nil
+}
+
+func (a *moduleAssembler) assembleData(c *cursor) error {
+	// https://webassembly.github.io/spec/core/text/modules.html#data-segments
+	c.id()
+
+	var mode dataMode = &dataModePassive{}
+	var memory memoryIndex
+	hasMemory := false
+
+	if c.peekList("memory") {
+		mc := newCursor(c.next())
+		x, err := mc.index(&a.memories)
+		if err != nil {
+			return err
+		}
+		if err := mc.end(); err != nil {
+			return err
+		}
+		memory, hasMemory = memoryIndex(x), true
+	}
+
+	offset, ok, err := a.offset(c)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		mode = &dataModeActive{memory, offset}
+	} else if hasMemory {
+		return c.unexpected("offset")
+	}
+
+	init := []byte{}
+	for !c.done() {
+		s, err := c.string()
+		if err != nil {
+			return err
+		}
+		init = append(init, s...)
+	}
+
+	a.dataSegments = append(a.dataSegments, data{init, mode})
+	return nil
+}
+
+// Types
+// https://webassembly.github.io/spec/core/text/types.html
+
+func (a *moduleAssembler) valueType(c *cursor) (ValueType, error) {
+	if c.peekKind(tokenKeyword) {
+		var valueType ValueType
+		switch c.peek().token.text {
+		case "i32":
+			valueType = ValueTypeI32
+		case "i64":
+			valueType = ValueTypeI64
+		case "f32":
+			valueType = ValueTypeF32
+		case "f64":
+			valueType = ValueTypeF64
+		case "v128":
+			valueType = ValueTypeV128
+		case "funcref":
+			valueType = ValueTypeFuncRef
+		case "externref":
+			valueType = ValueTypeExternRef
+		}
+
+		if valueType != nil {
+			c.next()
+			return valueType, nil
+		}
+	}
+
+	return nil, c.unexpected("value type")
+}
+
+func (a *moduleAssembler) referenceType(c *cursor) (*referenceType, error) {
+	pos := c.i
+	valueType, err := a.valueType(c)
+	if err != nil {
+		return nil, err
+	}
+
+	referenceType, ok := valueType.(*referenceType)
+	if !ok {
+		c.i = pos
+		return nil, c.unexpected("reference type")
+	}
+	return referenceType, nil
+}
+
+// heapType consumes the heap type of ref.null and returns the matching reference type.
+func (a *moduleAssembler) heapType(c *cursor) (*referenceType, error) {
+	switch {
+	case c.keyword("func"):
+		return ValueTypeFuncRef, nil
+	case c.keyword("extern"):
+		return ValueTypeExternRef, nil
+	default:
+		return nil, c.unexpected("heap type")
+	}
+}
+
+// functionType consumes parameters and results and returns the identifiers of the
+// parameters, which are empty for anonymous ones.
+func (a *moduleAssembler) functionType(c *cursor) (FunctionType, []string, error) {
+	// https://webassembly.github.io/spec/core/text/types.html#function-types
+	var result FunctionType
+	var ids []string
+
+	for c.peekList("param") {
+		pc := newCursor(c.next())
+		if pc.peekKind(tokenId) {
+			ids = append(ids, pc.next().token.text)
+			valueType, err := a.valueType(pc)
+			if err != nil {
+				return FunctionType{}, nil, err
+			}
+			result.ParameterTypes = append(result.ParameterTypes, valueType)
+
+			if err := pc.end(); err != nil {
+				return FunctionType{}, nil, err
+			}
+			continue
+		}
+
+		for !pc.done() {
+			valueType, err := a.valueType(pc)
+			if err != nil {
+				return FunctionType{}, nil, err
+			}
+			ids = append(ids, "")
+			result.ParameterTypes = append(result.ParameterTypes, valueType)
+		}
+	}
+
+	for c.peekList("result") {
+		rc := newCursor(c.next())
+		for !rc.done() {
+			valueType, err := a.valueType(rc)
+			if err != nil {
+				return FunctionType{}, nil, err
+			}
+			result.ResultTypes = append(result.ResultTypes, valueType)
+		}
+	}
+
+	if c.peekList("param") {
+		return FunctionType{}, nil, c.errorf(ErrUnexpectedToken, "parameters after results")
+	}
+
+	return result, ids, nil
+}
+
+// typeUse consumes a reference to a function type, which is either explicit, inline or both.
+// Inline types that do not match an existing type are added to the type section.
+// https://webassembly.github.io/spec/core/text/modules.html#type-uses
+func (a *moduleAssembler) typeUse(c *cursor) (typeIndex, FunctionType, []string, error) {
+	if !c.peekList("type") {
+		functionType, ids, err := a.functionType(c)
+		if err != nil {
+			return 0, FunctionType{}, nil, err
+		}
+		return a.implicitType(functionType), functionType, ids, nil
+	}
+
+	typeList := c.next()
+	tc := newCursor(typeList)
+	x, err := tc.index(&a.typeIds)
+	if err != nil {
+		return 0, FunctionType{}, nil, err
+	}
+	if err := tc.end(); err != nil {
+		return 0, FunctionType{}, nil, err
+	}
+
+	pos := c.position()
+	inline, ids, err := a.functionType(c)
+	if err != nil {
+		return 0, FunctionType{}, nil, err
+	}
+
+	hasInline := len(inline.ParameterTypes) > 0 || len(inline.ResultTypes) > 0
+
+	// Validation reports indices out of range, unless there is an inline type to match
+	if int(x) >= len(a.types) {
+		if hasInline {
+			return 0, FunctionType{}, nil, syntaxErrorf(typeList.token.pos, ErrUnknownType, "%d", x)
+		}
+		return typeIndex(x), FunctionType{}, ids, nil
+	}
+
+	functionType := a.types[x]
+	if hasInline && !equalFunctionTypes(inline, functionType) {
+		return 0, FunctionType{}, nil, syntaxErrorf(pos, ErrUnexpectedToken, "inline function type does not match type %d", x)
+	}
+
+	return typeIndex(x), functionType, ids, nil
+}
+
+// implicitType returns the index of the first type equal to functionType, adding it to the
+// type section if there is none.
+func (a *moduleAssembler) implicitType(functionType FunctionType) typeIndex {
+	for i, t := range a.types {
+		if equalFunctionTypes(t, functionType) {
+			return typeIndex(i)
+		}
+	}
+
+	a.types = append(a.types, functionType)
+	return typeIndex(len(a.types) - 1)
+}
+
+func equalFunctionTypes(a, b FunctionType) bool {
+	return slices.Equal(a.ParameterTypes, b.ParameterTypes) && slices.Equal(a.ResultTypes, b.ResultTypes)
+}
+
+func (a *moduleAssembler) limits(c *cursor) (Limits, error) {
+	min, err := c.uint32()
+	if err != nil {
+		return Limits{}, err
+	}
+
+	if !c.peekKind(tokenNumber) {
+		return Limits{min, nil}, nil
+	}
+
+	max, err := c.uint32()
+	if err != nil {
+		return Limits{}, err
+	}
+	return Limits{min, &max}, nil
+}
+
+func (a *moduleAssembler) tableType(c *cursor) (TableType, error) {
+	limits, err := a.limits(c)
+	if err != nil {
+		return TableType{}, err
+	}
+
+	elementType, err := a.referenceType(c)
+	if err != nil {
+		return TableType{}, err
+	}
+
+	return TableType{elementType, limits}, nil
+}
+
+func (a *moduleAssembler) globalType(c *cursor) (GlobalType, error) {
+	if c.peekList("mut") {
+		mc := newCursor(c.next())
+		valueType, err := a.valueType(mc)
+		if err != nil {
+			return GlobalType{}, err
+		}
+		return GlobalType{valueType, true}, mc.end()
+	}
+
+	valueType, err := a.valueType(c)
+	if err != nil {
+		return GlobalType{}, err
+	}
+	return GlobalType{valueType, false}, nil
+}
+
+// Instructions
+// https://webassembly.github.io/spec/core/text/instructions.html
+
+// textOpcodes maps the names of instructions in the text format to their opcodes.
+var textOpcodes = func() map[string]opcode {
+	result := make(map[string]opcode)
+	for op, name := range opcodeNames {
+		// The typed select shares its name with the untyped one
+		if op != 0x1C {
+			result[name] = op
+		}
+	}
+	return result
+}()
+
+// functionScope holds the identifiers that are bound inside a function body.
+type functionScope struct {
+	locals indexSpace
+	// labels holds the labels of the enclosing blocks, the innermost block last
+	labels []string
+}
+
+func newFunctionScope() *functionScope {
+	return &functionScope{locals: newIndexSpace("local")}
+}
+
+// wrapError locates errors that are not located yet at the current position of c.
+func (a *moduleAssembler) wrapError(c *cursor, err error) error {
+	if _, ok := err.(*SyntaxError); ok {
+		return err
+	}
+	return c.errorf(ErrUnexpectedToken, "%v", err)
+}
+
+// constantExpression consumes the remaining items of c as a constant expression.
+func (a *moduleAssembler) constantExpression(c *cursor) ([]instruction, error) {
+	expression, err := a.instructions(c, newFunctionScope())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.end(); err != nil {
+		return nil, err
+	}
+
+	return expression, nil
+}
+
+// instructions consumes plain and folded instructions until the end of c or until the
+// keyword end or else.
+func (a *moduleAssembler) instructions(c *cursor, scope *functionScope) ([]instruction, error) {
+	var result []instruction
+
+	for !c.done() {
+		item := c.peek()
+		if item.isKeyword("end") || item.isKeyword("else") {
+			break
+		}
+		c.next()
+
+		if item.list {
+			folded, err := a.foldedInstruction(item, scope)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, folded...)
+			continue
+		}
+
+		if item.token.kind != tokenKeyword {
+			c.i--
+			return nil, c.unexpected("instruction")
+		}
+
+		var instruction instruction
+		var err error
+		switch item.token.text {
+		case "block", "loop", "if":
+			instruction, err = a.blockInstruction(item.token.text, c, scope)
+		default:
+			instruction, err = a.plainInstruction(item, c, scope)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, instruction)
+	}
+
+	return result, nil
+}
+
+// blockInstruction consumes a structured instruction in its flat form after its keyword.
+func (a *moduleAssembler) blockInstruction(kind string, c *cursor, scope *functionScope) (instruction, error) {
+	label := c.id()
+	bt, err := a.blockType(c)
+	if err != nil {
+		return nil, err
+	}
+
+	scope.labels = append(scope.labels, label)
+	defer func() { scope.labels = scope.labels[:len(scope.labels)-1] }()
+
+	instructions, err := a.instructions(c, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var elseInstructions []instruction
+	if kind == "if" && c.keyword("else") {
+		if err := a.endLabel(c, label); err != nil {
+			return nil, err
+		}
+
+		elseInstructions, err = a.instructions(c, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		if elseInstructions == nil {
+			elseInstructions = []instruction{}
+		}
+	}
+
+	if !c.keyword("end") {
+		return nil, c.unexpected("end")
+	}
+
+	if err := a.endLabel(c, label); err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case "block":
+		return &block{bt, instructions}, nil
+	case "loop":
+		return &loop{bt, instructions}, nil
+	default:
+		return &ifInstruction{bt, instructions, elseInstructions}, nil
+	}
+}
+
+// endLabel consumes the optional label after end and else, which must repeat the label of
+// the block.
+func (a *moduleAssembler) endLabel(c *cursor, label string) error {
+	if !c.peekKind(tokenId) {
+		return nil
+	}
+
+	if c.peek().token.text != label {
+		return c.errorf(ErrUnexpectedToken, "mismatching label [%s], expected [%s]", c.peek().token.text, label)
+	}
+
+	c.next()
+	return nil
+}
+
+// foldedInstruction assembles a folded instruction, its operands precede it in the result.
+// https://webassembly.github.io/spec/core/text/instructions.html#folded-instructions
+func (a *moduleAssembler) foldedInstruction(list *sexpr, scope *functionScope) ([]instruction, error) {
+	if len(list.children) == 0 || list.children[0].list || list.children[0].token.kind != tokenKeyword {
+		return nil, syntaxErrorf(list.token.pos, ErrUnexpectedToken, "expected instruction, got [%s]", list)
+	}
+
+	op := list.children[0]
+	c := newCursor(list)
+
+	switch op.token.text {
+	case "block", "loop":
+		label := c.id()
+		bt, err := a.blockType(c)
+		if err != nil {
+			return nil, err
+		}
+
+		scope.labels = append(scope.labels, label)
+		instructions, err := a.instructions(c, scope)
+		scope.labels = scope.labels[:len(scope.labels)-1]
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.end(); err != nil {
+			return nil, err
+		}
+
+		if op.token.text == "block" {
+			return []instruction{&block{bt, instructions}}, nil
+		}
+		return []instruction{&loop{bt, instructions}}, nil
+	case "if":
+		label := c.id()
+		bt, err := a.blockType(c)
+		if err != nil {
+			return nil, err
+		}
+
+		// The condition is given by folded instructions before the branches
+		var result []instruction
+		for !c.done() && c.peek().list && !c.peekList("then") {
+			condition, err := a.foldedInstruction(c.next(), scope)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, condition...)
+		}
+
+		scope.labels = append(scope.labels, label)
+		defer func() { scope.labels = scope.labels[:len(scope.labels)-1] }()
+
+		tc, err := c.listCursor("then")
+		if err != nil {
+			return nil, err
+		}
+
+		instructions, err := a.instructions(tc, scope)
+		if err != nil {
+			return nil, err
+		}
+		if err := tc.end(); err != nil {
+			return nil, err
+		}
+
+		var elseInstructions []instruction
+		if c.peekList("else") {
+			ec := newCursor(c.next())
+			elseInstructions, err = a.instructions(ec, scope)
+			if err != nil {
+				return nil, err
+			}
+			if err := ec.end(); err != nil {
+				return nil, err
+			}
+
+			if elseInstructions == nil {
+				elseInstructions = []instruction{}
+			}
+		}
+
+		if err := c.end(); err != nil {
+			return nil, err
+		}
+
+		return append(result, &ifInstruction{bt, instructions, elseInstructions}), nil
+	default:
+		plain, err := a.plainInstruction(op, c, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []instruction
+		for !c.done() {
+			if !c.peek().list {
+				return nil, c.unexpected("folded instruction")
+			}
+
+			operand, err := a.foldedInstruction(c.next(), scope)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, operand...)
+		}
+
+		return append(result, plain), nil
+	}
+}
+
+func (a *moduleAssembler) blockType(c *cursor) (blockType, error) {
+	// https://webassembly.github.io/spec/core/text/instructions.html#text-blocktype
+	pos := c.position()
+	explicit := c.peekList("type")
+
+	var x typeIndex
+	var functionType FunctionType
+	var ids []string
+	var err error
+	if explicit {
+		x, functionType, ids, err = a.typeUse(c)
+	} else {
+		functionType, ids, err = a.functionType(c)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlike function parameters, block parameters cannot be referred to by identifiers
+	for _, id := range ids {
+		if id != "" {
+			return nil, syntaxErrorf(pos, ErrUnexpectedToken, "block parameter %s", id)
+		}
+	}
+
+	switch {
+	case explicit:
+		return &blockTypeIndex{x}, nil
+	case len(functionType.ParameterTypes) == 0 && len(functionType.ResultTypes) == 0:
+		return &blockTypeEmpty{}, nil
+	case len(functionType.ParameterTypes) == 0 && len(functionType.ResultTypes) == 1:
+		return &blockTypeValue{functionType.ResultTypes[0]}, nil
+	default:
+		return &blockTypeIndex{a.implicitType(functionType)}, nil
+	}
+}
+
+// label consumes a label index, given as a number or as the label of an enclosing block.
+func (a *moduleAssembler) label(c *cursor, scope *functionScope) (labelIndex, error) {
+	if c.peekKind(tokenId) {
+		id := c.peek().token.text
+		for i := len(scope.labels) - 1; i >= 0; i-- {
+			if scope.labels[i] == id {
+				c.next()
+				return labelIndex(len(scope.labels) - 1 - i), nil
+			}
+		}
+		return 0, c.errorf(ErrUnknownIdentifier, "label %s", id)
+	}
+
+	if !c.peekKind(tokenNumber) {
+		return 0, c.unexpected("label")
+	}
+
+	l, err := c.uint32()
+	return labelIndex(l), err
+}
+
+// optionalIndex consumes an index if there is one and returns 0 otherwise.
+func (c *cursor) optionalIndex(space *indexSpace) (uint32, error) {
+	if !c.peekIndex() {
+		return 0, nil
+	}
+	return c.index(space)
+}
+
+// plainInstruction consumes the immediates of the instruction named by op.
+func (a *moduleAssembler) plainInstruction(op *sexpr, c *cursor, scope *functionScope) (instruction, error) {
+	name := op.token.text
+
+	switch name {
+	case "block", "loop", "if", "then", "else", "end", "type", "param", "result", "local":
+		return nil, syntaxErrorf(op.token.pos, ErrUnexpectedToken, "misplaced [%s]", name)
+	// Control Instructions
+	case "br", "br_if":
+		l, err := a.label(c, scope)
+		if err != nil {
+			return nil, err
+		}
+		if name == "br" {
+			return &br{l}, nil
+		}
+		return &brIf{l}, nil
+	case "br_table":
+		var labels []labelIndex
+		for c.peekIndex() {
+			l, err := a.label(c, scope)
+			if err != nil {
+				return nil, err
+			}
+			labels = append(labels, l)
+		}
+
+		if len(labels) == 0 {
+			return nil, c.unexpected("label")
+		}
+
+		// The last label is the default, an empty vector of labels is nil as in the parser
+		var l []labelIndex
+		if len(labels) > 1 {
+			l = labels[:len(labels)-1]
+		}
+		return &brTable{l, labels[len(labels)-1]}, nil
+	case "call":
+		x, err := c.index(&a.functions)
+		return &call{functionIndex(x)}, err
+	case "call_indirect":
+		x, err := c.optionalIndex(&a.tables)
+		if err != nil {
+			return nil, err
+		}
+
+		y, _, ids, err := a.typeUse(c)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(ids, func(id string) bool { return id != "" }) {
+			return nil, c.errorf(ErrUnexpectedToken, "call_indirect must not bind parameter identifiers")
+		}
+		return &callIndirect{y, tableIndex(x)}, nil
+	// Reference Instructions
+	case "ref.null":
+		t, err := a.heapType(c)
+		return &refNull{t}, err
+	case "ref.func":
+		x, err := c.index(&a.functions)
+		return &refFunc{functionIndex(x)}, err
+	// Parametric Instructions
+	case "select":
+		if !c.peekList("result") {
+			return &selectInstruction{}, nil
+		}
+
+		functionType, _, err := a.functionType(c)
+		if err != nil {
+			return nil, err
+		}
+		return &selectTyped{functionType.ResultTypes}, nil
+	// Variable Instructions
+	case "local.get", "local.set", "local.tee":
+		x, err := c.index(&scope.locals)
+		if err != nil {
+			return nil, err
+		}
+
+		switch name {
+		case "local.get":
+			return &localGet{localIndex(x)}, nil
+		case "local.set":
+			return &localSet{localIndex(x)}, nil
+		default:
+			return &localTee{localIndex(x)}, nil
+		}
+	case "global.get", "global.set":
+		x, err := c.index(&a.globals)
+		if err != nil {
+			return nil, err
+		}
+
+		if name == "global.get" {
+			return &globalGet{globalIndex(x)}, nil
+		}
+		return &globalSet{globalIndex(x)}, nil
+	// Table Instructions
+	case "table.get", "table.set", "table.size", "table.grow", "table.fill":
+		x, err := c.optionalIndex(&a.tables)
+		if err != nil {
+			return nil, err
+		}
+
+		switch name {
+		case "table.get":
+			return &tableGet{tableIndex(x)}, nil
+		case "table.set":
+			return &tableSet{tableIndex(x)}, nil
+		case "table.size":
+			return &tableSize{tableIndex(x)}, nil
+		case "table.grow":
+			return &tableGrow{tableIndex(x)}, nil
+		default:
+			return &tableFill{tableIndex(x)}, nil
+		}
+	case "table.init":
+		// The table index is optional and precedes the element index
+		var x uint32
+		if c.i+1 < len(c.items) && !c.items[c.i+1].list && (c.items[c.i+1].token.kind == tokenNumber || c.items[c.i+1].token.kind == tokenId) {
+			var err error
+			x, err = c.index(&a.tables)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		y, err := c.index(&a.elements)
+		return &tableInit{elementIndex(y), tableIndex(x)}, err
+	case "table.copy":
+		if !c.peekIndex() {
+			return &tableCopy{0, 0}, nil
+		}
+
+		x, err := c.index(&a.tables)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := c.index(&a.tables)
+		return &tableCopy{tableIndex(x), tableIndex(y)}, err
+	case "elem.drop":
+		x, err := c.index(&a.elements)
+		return &elemDrop{elementIndex(x)}, err
+	// Memory Instructions
+	case "memory.init":
+		a.usesDataCount = true
+		x, err := c.index(&a.datas)
+		return &memoryInit{dataIndex(x)}, err
+	case "data.drop":
+		a.usesDataCount = true
+		x, err := c.index(&a.datas)
+		return &dataDrop{dataIndex(x)}, err
+	// Numeric Instructions
+	case "i32.const":
+		n, err := c.integer(32)
+		return &int32Const{int32(uint32(n))}, err
+	case "i64.const":
+		n, err := c.integer(64)
+		return &int64Const{int64(n)}, err
+	case "f32.const":
+		z, err := c.float(32)
+		return &float32Const{math.Float32frombits(uint32(z))}, err
+	case "f64.const":
+		z, err := c.float(64)
+		return &float64Const{math.Float64frombits(z)}, err
+	}
+
+	op2, ok := textOpcodes[name]
+	if !ok {
+		return nil, syntaxErrorf(op.token.pos, ErrUnknownOperator, "[%s]", name)
+	}
+
+	if op2 >= 0x28 && op2 <= 0x3E {
+		m, err := a.memoryArgument(c, naturalAlignment(op2))
+		if err != nil {
+			return nil, err
+		}
+		return newMemoryInstruction(byte(op2), m)
+	}
+
+	return newInstruction(op2)
+}
+
+func (a *moduleAssembler) memoryArgument(c *cursor, natural uint32) (memoryArgument, error) {
+	// https://webassembly.github.io/spec/core/text/instructions.html#memory-instructions
+	m := memoryArgument{natural, 0}
+
+	if c.peekKind(tokenKeyword) && strings.HasPrefix(c.peek().token.text, "offset=") {
+		offset, err := parseUnsigned(strings.TrimPrefix(c.peek().token.text, "offset="), 32)
+		if err != nil {
+			return memoryArgument{}, c.errorf(ErrConstantOutOfRange, "%v", err)
+		}
+		m.offset = uint32(offset)
+		c.next()
+	}
+
+	if c.peekKind(tokenKeyword) && strings.HasPrefix(c.peek().token.text, "align=") {
+		align, err := parseUnsigned(strings.TrimPrefix(c.peek().token.text, "align="), 32)
+		if err != nil || bits.OnesCount64(align) != 1 {
+			return memoryArgument{}, c.errorf(ErrUnexpectedToken, "alignment must be a power of two, got [%s]", c.peek().token.text)
+		}
+		m.align = uint32(bits.TrailingZeros64(align))
+		c.next()
+	}
+
+	return m, nil
+}
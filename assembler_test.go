@@ -0,0 +1,183 @@
+package jwasm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func assemble(t *testing.T, text string) *Module {
+	t.Helper()
+
+	module, err := (&Assembler{}).Assemble(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return module
+}
+
+func TestAssemblingAbbreviations(t *testing.T) {
+	abbreviated := assemble(t, `(module $m
+  (type $binary (func (param i32 i32) (result i32)))
+  (func $log (import "env" "log") (param i32))
+  (memory (export "mem") (data "hello"))
+  (table $t (export "tab") funcref (elem $add $log))
+  (global $counter (mut i32) (i32.const 0))
+  (func $add (export "add") (type $binary) (param $a i32) (param $b i32) (result i32)
+    (local $sum i32) (local i64 i64)
+    (local.set $sum (i32.add (local.get $a) (local.get $b)))
+    (block $done
+      (br_if $done (i32.eqz (local.get $sum)))
+      (call $log (local.get $sum)))
+    (if (result i32) (local.get $a)
+      (then (i32.load8_u offset=1 (i32.const 0)))
+      (else (local.get $sum))))
+  (elem (i32.const 1) $add)
+  (data (i32.const 5) "!")
+  (start $init)
+  (func $init
+    global.get $counter
+    i32.const 1
+    i32.add
+    global.set $counter))`)
+
+	expanded := assemble(t, `(module
+  (type (func (param i32 i32) (result i32)))
+  (type (func (param i32)))
+  (type (func))
+  (import "env" "log" (func (type 1)))
+  (func (type 0) (local i32 i64 i64)
+    local.get 0
+    local.get 1
+    i32.add
+    local.set 2
+    block
+      local.get 2
+      i32.eqz
+      br_if 0
+      local.get 2
+      call 0
+    end
+    local.get 0
+    if (result i32)
+      i32.const 0
+      i32.load8_u offset=1 align=1
+    else
+      local.get 2
+    end)
+  (func (type 2) global.get 0 i32.const 1 i32.add global.set 0)
+  (table 2 2 funcref)
+  (memory 1 1)
+  (global (mut i32) i32.const 0)
+  (export "mem" (memory 0))
+  (export "tab" (table 0))
+  (export "add" (func 1))
+  (start 2)
+  (elem (table 0) (offset i32.const 0) func 1 0)
+  (elem (i32.const 1) func 1)
+  (data (memory 0) (offset (i32.const 0)) "hel" "lo")
+  (data (i32.const 5) "!"))`)
+
+	assert.Equal(t, expanded, abbreviated)
+	assert.Equal(t, []locals{{1, ValueTypeI32}, {2, ValueTypeI64}}, abbreviated.CodeSection.functionCode[0].locals)
+	assert.Equal(t, Limits{2, ptr(uint32(2))}, abbreviated.TableSection.tables[0].Limits)
+	assert.Nil(t, abbreviated.DataCountSection)
+}
+
+func TestAssemblingModuleFields(t *testing.T) {
+	module := assemble(t, `(func (result i32) (i32.const 42))`)
+
+	assert.Equal(t, []FunctionType{{nil, ResultType{ValueTypeI32}}}, module.TypeSection.FunctionTypes)
+	assert.Equal(t, []functionCode{{nil, []instruction{&int32Const{42}}}}, module.CodeSection.functionCode)
+}
+
+func TestAssemblingPrintedModule(t *testing.T) {
+	data, err := hex.DecodeString(encoderTestModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	module, err := (&Parser{}).Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Custom sections are printed as comments, so the assembled module lacks the leading
+	// section "a" and the trailing name section
+	expected := strings.TrimSuffix(encoderTestModule, "000b046e616d65010401000166")
+	expected = strings.Replace(expected, "00050161010203", "", 1)
+
+	for _, folded := range []bool{false, true} {
+		var text strings.Builder
+		err := (&Printer{Folded: folded}).Print(&text, module)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		err = NewEncoder(&buf).Encode(assemble(t, text.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, expected, hex.EncodeToString(buf.Bytes()), "folded: %v", folded)
+	}
+}
+
+func TestAssemblingReportsSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		text   string
+		err    error
+		line   int
+		column int
+	}{
+		{"(module (func (i32.bogus)))", ErrUnknownOperator, 1, 16},
+		{"(module\n  (func\n    nop\n    foo))", ErrUnknownOperator, 4, 5},
+		{"(module (func call $missing))", ErrUnknownIdentifier, 1, 20},
+		{"(module (func block br $missing end))", ErrUnknownIdentifier, 1, 24},
+		{"(module (func $f) (func $f))", ErrDuplicateIdentifier, 1, 25},
+		{"(module (func (param $x i32) (local $x i32)))", ErrDuplicateIdentifier, 1, 37},
+		{"(module (func i32.const 4294967296))", ErrConstantOutOfRange, 1, 25},
+		{"(module (func block $a end $b))", ErrUnexpectedToken, 1, 28},
+		{"(module (func i32.load align=3))", ErrUnexpectedToken, 1, 24},
+		{"(module (memory 1) (func $f (import \"a\" \"b\")))", ErrUnexpectedToken, 1, 29},
+		{"(module (func block (param $x i32) end))", ErrUnexpectedToken, 1, 21},
+		{"(module (type (func (result i32) (param i32))))", ErrUnexpectedToken, 1, 34},
+		{"(module (func (type 1) (param i32)))", ErrUnknownType, 1, 15},
+	}
+
+	for _, test := range tests {
+		_, err := (&Assembler{}).Assemble(strings.NewReader(test.text))
+
+		var syntaxError *SyntaxError
+		if assert.ErrorAs(t, err, &syntaxError, test.text) {
+			assert.ErrorIs(t, err, test.err, test.text)
+			assert.Equal(t, test.line, syntaxError.Line, test.text)
+			assert.Equal(t, test.column, syntaxError.Column, test.text)
+		}
+	}
+}
+
+func TestAssemblingLeavesInvalidModulesToValidation(t *testing.T) {
+	for _, test := range []struct {
+		text string
+		err  error
+	}{
+		{"(module (global i32 (local.get 0)))", ErrConstantExpressionRequired},
+		{"(module (func (type 1)))", ErrUnknownType},
+	} {
+		module, err := (&Assembler{}).Assemble(strings.NewReader(test.text))
+		if assert.NoError(t, err, test.text) {
+			assert.ErrorIs(t, Validate(module), test.err, test.text)
+		}
+	}
+}
+
+func TestAssemblingRejectsMalformedNames(t *testing.T) {
+	_, err := (&Assembler{}).Assemble(strings.NewReader(`(module (export "\ff" (func 0)) (func))`))
+	assert.True(t, errors.As(err, new(*SyntaxError)))
+}
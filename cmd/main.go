@@ -2,27 +2,105 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
-	"path"
+	"path/filepath"
 
 	"github.com/jcklie/jwasm"
 )
 
-var strFlag = flag.String("f", "<default>", "input file name")
+var strFlag = flag.String("f", "<default>", "input file name, .wat files are assembled from the text format and .wast scripts are run")
+var watFlag = flag.Bool("wat", false, "print the module in the text format")
+var foldedFlag = flag.Bool("folded", false, "print instructions as folded expressions, requires -wat")
+var validateFlag = flag.Bool("validate", false, "report all module-level problems and the first invalid instruction")
 
 func main() {
 	flag.Parse()
 
-	file, err := os.Open(path.Join("testdata/wat2wasm/", *strFlag))
+	file, err := os.Open(*strFlag)
 
 	if err != nil {
 		panic(err)
 	}
+	defer file.Close()
 
-	parser := jwasm.Parser{}
-	err = parser.Parse(file)
+	if filepath.Ext(*strFlag) == ".wast" {
+		runner := jwasm.ScriptRunner{}
+		results, err := runner.Run(*strFlag, file)
+		if err != nil {
+			panic(err)
+		}
+
+		failed := false
+		for _, result := range results {
+			fmt.Println(result)
+			failed = failed || result.Status == jwasm.ScriptFailed
+		}
+
+		if failed {
+			os.Exit(1)
+		}
+		return
+	}
+
+	var module *jwasm.Module
+	if filepath.Ext(*strFlag) == ".wat" {
+		assembler := jwasm.Assembler{}
+		module, err = assembler.Assemble(file)
+	} else {
+		parser := jwasm.Parser{}
+		module, err = parser.Parse(file)
+	}
 
 	if err != nil {
 		panic(err)
 	}
+
+	if *validateFlag {
+		diagnostics := jwasm.ValidateModule(module)
+		for _, diagnostic := range diagnostics {
+			fmt.Println(diagnostic)
+		}
+
+		if len(diagnostics) > 0 {
+			os.Exit(1)
+		}
+
+		if err := jwasm.Validate(module); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *watFlag {
+		printer := jwasm.Printer{Folded: *foldedFlag}
+		err := printer.Print(os.Stdout, module)
+		if err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	for _, section := range module.Sections {
+		fmt.Printf("Section: %+v\n", section)
+	}
+
+	imports, err := module.Imports()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, imp := range imports {
+		fmt.Printf("Import: %s.%s %s %d\n", imp.Module, imp.Name, imp.Kind, imp.Index)
+	}
+
+	exports, err := module.Exports()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, exp := range exports {
+		fmt.Printf("Export: %s %s %d\n", exp.Name, exp.Kind, exp.Index)
+	}
 }
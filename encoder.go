@@ -0,0 +1,463 @@
+package jwasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"io"
+	"math"
+)
+
+// Encoder writes modules in the WebAssembly binary format.
+//
+// Integers are written in their shortest LEB128 encoding. Sections of decoded modules that
+// are unmodified are written as they were decoded instead, so that decoding a module and
+// encoding it again reproduces the original binary.
+type Encoder struct {
+	w io.Writer
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w}
+}
+
+// Encode writes m with its sections in the order of m.Sections.
+func (e *Encoder) Encode(m *Module) error {
+	b := binary.BigEndian.AppendUint32(nil, WASM_BINARY_MAGIC)
+	b = binary.BigEndian.AppendUint32(b, WASM_BINARY_VERSION)
+
+	for _, section := range m.Sections {
+		contents, err := appendSectionContents(nil, section)
+		if err != nil {
+			return fmt.Errorf("encoding %s section failed: %w", section.id(), err)
+		}
+
+		if raw, ok := m.raw[section]; ok && raw.hash == maphash.Bytes(rawSeed, contents) {
+			if raw.contents != nil {
+				contents = raw.contents
+			}
+
+			b = append(b, byte(section.id()))
+			b = appendPaddedUnsigned(b, uint64(len(contents)), raw.sizeWidth)
+			b = append(b, contents...)
+			continue
+		}
+
+		// https://webassembly.github.io/spec/core/binary/modules.html#sections
+		b = append(b, byte(section.id()))
+		b = appendUnsigned(b, uint64(len(contents)))
+		b = append(b, contents...)
+	}
+
+	_, err := e.w.Write(b)
+	if err != nil {
+		return fmt.Errorf("writing module failed: %w", err)
+	}
+
+	return nil
+}
+
+// rawSeed seeds the hashes that tell modified sections from unmodified ones.
+var rawSeed = maphash.MakeSeed()
+
+// keep reduces raw, the encoding section was decoded from, to what is needed to encode the
+// section the same way again. It reports false if raw is the shortest encoding, which the
+// encoder writes anyway.
+func (raw *rawSection) keep(section Section) bool {
+	shortest, err := appendSectionContents(nil, section)
+	if err != nil {
+		return false
+	}
+
+	if bytes.Equal(shortest, raw.contents) {
+		if raw.sizeWidth == len(appendUnsigned(nil, uint64(len(shortest)))) {
+			return false
+		}
+		raw.contents = nil
+	}
+
+	raw.hash = maphash.Bytes(rawSeed, shortest)
+	return true
+}
+
+func appendSectionContents(b []byte, section Section) ([]byte, error) {
+	switch s := section.(type) {
+	case *CustomSection:
+		b = appendName(b, s.Name)
+		return append(b, s.Data...), nil
+	case *TypeSection:
+		b = appendUnsigned(b, uint64(len(s.FunctionTypes)))
+		for _, functionType := range s.FunctionTypes {
+			b = appendFunctionType(b, functionType)
+		}
+		return b, nil
+	case *ImportSection:
+		b = appendUnsigned(b, uint64(len(s.imports)))
+		for _, imp := range s.imports {
+			b = appendName(b, imp.module)
+			b = appendName(b, imp.name)
+			switch desc := imp.importDescription.(type) {
+			case *importDescriptionFunc:
+				b = append(b, 0x00)
+				b = appendUnsigned(b, uint64(desc.typeIndex))
+			case *importDescriptionTable:
+				b = append(b, 0x01)
+				b = appendTableType(b, desc.tableType)
+			case *importDescriptionMem:
+				b = append(b, 0x02)
+				b = appendLimits(b, desc.memoryType.Limits)
+			case *importDescriptionGlobal:
+				b = append(b, 0x03)
+				b = appendGlobalType(b, desc.globalType)
+			default:
+				return nil, fmt.Errorf("unknown import description %T", desc)
+			}
+		}
+		return b, nil
+	case *FunctionSection:
+		b = appendUnsigned(b, uint64(len(s.typeIndices)))
+		for _, typeIdx := range s.typeIndices {
+			b = appendUnsigned(b, uint64(typeIdx))
+		}
+		return b, nil
+	case *TableSection:
+		b = appendUnsigned(b, uint64(len(s.tables)))
+		for _, tableType := range s.tables {
+			b = appendTableType(b, tableType)
+		}
+		return b, nil
+	case *MemorySection:
+		b = appendUnsigned(b, uint64(len(s.memories)))
+		for _, memoryType := range s.memories {
+			b = appendLimits(b, memoryType.Limits)
+		}
+		return b, nil
+	case *GlobalSection:
+		b = appendUnsigned(b, uint64(len(s.globals)))
+		for _, global := range s.globals {
+			b = appendGlobalType(b, global.globalType)
+			b = appendExpression(b, global.init)
+		}
+		return b, nil
+	case *ExportSection:
+		b = appendUnsigned(b, uint64(len(s.exports)))
+		for _, exp := range s.exports {
+			b = appendName(b, exp.name)
+			switch desc := exp.exportDescription.(type) {
+			case *exportDescriptionFunc:
+				b = append(b, 0x00)
+				b = appendUnsigned(b, uint64(desc.functionIndex))
+			case *exportDescriptionTable:
+				b = append(b, 0x01)
+				b = appendUnsigned(b, uint64(desc.tableIndex))
+			case *exportDescriptionMem:
+				b = append(b, 0x02)
+				b = appendUnsigned(b, uint64(desc.memoryIndex))
+			case *exportDescriptionGlobal:
+				b = append(b, 0x03)
+				b = appendUnsigned(b, uint64(desc.globalIndex))
+			default:
+				return nil, fmt.Errorf("unknown export description %T", desc)
+			}
+		}
+		return b, nil
+	case *StartSection:
+		return appendUnsigned(b, uint64(s.start)), nil
+	case *ElementSection:
+		b = appendUnsigned(b, uint64(len(s.elements)))
+		for _, element := range s.elements {
+			var err error
+			b, err = appendElement(b, element)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case *CodeSection:
+		b = appendUnsigned(b, uint64(len(s.functionCode)))
+		for _, functionCode := range s.functionCode {
+			code := appendUnsigned(nil, uint64(len(functionCode.locals)))
+			for _, run := range functionCode.locals {
+				code = appendUnsigned(code, uint64(run.n))
+				code = append(code, valueTypeCode(run.valueType))
+			}
+			code = appendExpression(code, functionCode.body)
+
+			b = appendUnsigned(b, uint64(len(code)))
+			b = append(b, code...)
+		}
+		return b, nil
+	case *DataSection:
+		b = appendUnsigned(b, uint64(len(s.data)))
+		for _, data := range s.data {
+			switch mode := data.mode.(type) {
+			case *dataModePassive:
+				b = append(b, 0x01)
+			case *dataModeActive:
+				if mode.memory == 0 {
+					b = append(b, 0x00)
+				} else {
+					b = append(b, 0x02)
+					b = appendUnsigned(b, uint64(mode.memory))
+				}
+				b = appendExpression(b, mode.offset)
+			default:
+				return nil, fmt.Errorf("unknown data mode %T", mode)
+			}
+			b = appendUnsigned(b, uint64(len(data.init)))
+			b = append(b, data.init...)
+		}
+		return b, nil
+	case *DataCountSection:
+		return appendUnsigned(b, uint64(s.count)), nil
+	default:
+		return nil, fmt.Errorf("unknown section %T", section)
+	}
+}
+
+func appendElement(b []byte, element element) ([]byte, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#element-section
+	//
+	// The flags are derived from the segment, the most compact encoding is used for
+	// active segments of table 0 with function references.
+	usesExpressions := element.functionIndices == nil
+
+	var flags uint32
+	if usesExpressions {
+		flags |= 0b100
+	}
+
+	var active *elementModeActive
+	switch mode := element.mode.(type) {
+	case *elementModePassive:
+		flags |= 0b001
+	case *elementModeDeclarative:
+		flags |= 0b011
+	case *elementModeActive:
+		active = mode
+		if mode.table != 0 || element.elementType != ValueTypeFuncRef {
+			flags |= 0b010
+		}
+	default:
+		return nil, fmt.Errorf("unknown element mode %T", mode)
+	}
+
+	b = appendUnsigned(b, uint64(flags))
+
+	if active != nil {
+		if flags&0b010 != 0 {
+			b = appendUnsigned(b, uint64(active.table))
+		}
+		b = appendExpression(b, active.offset)
+	}
+
+	if flags&0b011 != 0 {
+		if usesExpressions {
+			b = append(b, valueTypeCode(element.elementType))
+		} else {
+			// Element kind for function references
+			b = append(b, 0x00)
+		}
+	}
+
+	if usesExpressions {
+		b = appendUnsigned(b, uint64(len(element.init)))
+		for _, expression := range element.init {
+			b = appendExpression(b, expression)
+		}
+	} else {
+		b = appendUnsigned(b, uint64(len(element.functionIndices)))
+		for _, x := range element.functionIndices {
+			b = appendUnsigned(b, uint64(x))
+		}
+	}
+
+	return b, nil
+}
+
+func appendName(b []byte, name string) []byte {
+	b = appendUnsigned(b, uint64(len(name)))
+	return append(b, name...)
+}
+
+func valueTypeCode(t ValueType) byte {
+	switch t := t.(type) {
+	case *numberType:
+		return byte(t.code)
+	case *vectorType:
+		return byte(t.code)
+	case *referenceType:
+		return byte(t.code)
+	default:
+		panic(fmt.Sprintf("unknown value type %T", t))
+	}
+}
+
+func appendResultType(b []byte, resultType ResultType) []byte {
+	b = appendUnsigned(b, uint64(len(resultType)))
+	for _, valueType := range resultType {
+		b = append(b, valueTypeCode(valueType))
+	}
+	return b
+}
+
+func appendFunctionType(b []byte, functionType FunctionType) []byte {
+	b = append(b, 0x60)
+	b = appendResultType(b, functionType.ParameterTypes)
+	return appendResultType(b, functionType.ResultTypes)
+}
+
+func appendLimits(b []byte, limits Limits) []byte {
+	if limits.Max == nil {
+		b = append(b, 0x00)
+		return appendUnsigned(b, uint64(limits.Min))
+	}
+
+	b = append(b, 0x01)
+	b = appendUnsigned(b, uint64(limits.Min))
+	return appendUnsigned(b, uint64(*limits.Max))
+}
+
+func appendTableType(b []byte, tableType TableType) []byte {
+	b = append(b, valueTypeCode(tableType.ElementType))
+	return appendLimits(b, tableType.Limits)
+}
+
+func appendGlobalType(b []byte, globalType GlobalType) []byte {
+	b = append(b, valueTypeCode(globalType.ValueType))
+	if globalType.Mutable {
+		return append(b, 0x01)
+	}
+	return append(b, 0x00)
+}
+
+// appendExpression appends the instructions followed by the end opcode.
+func appendExpression(b []byte, instructions []instruction) []byte {
+	for _, instruction := range instructions {
+		b = appendInstruction(b, instruction)
+	}
+	return append(b, 0x0B)
+}
+
+func appendOpcode(b []byte, op opcode) []byte {
+	if op > 0xFF {
+		b = append(b, byte(op>>8))
+		return appendUnsigned(b, uint64(op&0xFF))
+	}
+	return append(b, byte(op))
+}
+
+func appendBlockType(b []byte, bt blockType) []byte {
+	switch bt := bt.(type) {
+	case *blockTypeValue:
+		return append(b, valueTypeCode(bt.t))
+	case *blockTypeIndex:
+		return appendSigned(b, int64(bt.x))
+	default:
+		return append(b, 0x40)
+	}
+}
+
+func appendInstruction(b []byte, instruction instruction) []byte {
+	// https://webassembly.github.io/spec/core/binary/instructions.html
+	b = appendOpcode(b, instruction.opcode())
+
+	switch i := instruction.(type) {
+	// Control Instructions
+	case *block:
+		b = appendBlockType(b, i.bt)
+		return appendExpression(b, i.instructions)
+	case *loop:
+		b = appendBlockType(b, i.bt)
+		return appendExpression(b, i.instructions)
+	case *ifInstruction:
+		b = appendBlockType(b, i.bt)
+		if i.elseInstructions == nil {
+			return appendExpression(b, i.instructions)
+		}
+		for _, instruction := range i.instructions {
+			b = appendInstruction(b, instruction)
+		}
+		b = append(b, 0x05)
+		return appendExpression(b, i.elseInstructions)
+	case *br:
+		return appendUnsigned(b, uint64(i.l))
+	case *brIf:
+		return appendUnsigned(b, uint64(i.l))
+	case *brTable:
+		b = appendUnsigned(b, uint64(len(i.l)))
+		for _, l := range i.l {
+			b = appendUnsigned(b, uint64(l))
+		}
+		return appendUnsigned(b, uint64(i.lN))
+	case *call:
+		return appendUnsigned(b, uint64(i.x))
+	case *callIndirect:
+		b = appendUnsigned(b, uint64(i.y))
+		return appendUnsigned(b, uint64(i.x))
+	// Reference Instructions
+	case *refNull:
+		return append(b, valueTypeCode(i.t))
+	case *refFunc:
+		return appendUnsigned(b, uint64(i.x))
+	// Parametric Instructions
+	case *selectTyped:
+		return appendResultType(b, i.t)
+	// Variable Instructions
+	case *localGet:
+		return appendUnsigned(b, uint64(i.x))
+	case *localSet:
+		return appendUnsigned(b, uint64(i.x))
+	case *localTee:
+		return appendUnsigned(b, uint64(i.x))
+	case *globalGet:
+		return appendUnsigned(b, uint64(i.x))
+	case *globalSet:
+		return appendUnsigned(b, uint64(i.x))
+	// Table Instructions
+	case *tableGet:
+		return appendUnsigned(b, uint64(i.x))
+	case *tableSet:
+		return appendUnsigned(b, uint64(i.x))
+	case *tableInit:
+		b = appendUnsigned(b, uint64(i.y))
+		return appendUnsigned(b, uint64(i.x))
+	case *elemDrop:
+		return appendUnsigned(b, uint64(i.x))
+	case *tableCopy:
+		b = appendUnsigned(b, uint64(i.x))
+		return appendUnsigned(b, uint64(i.y))
+	case *tableGrow:
+		return appendUnsigned(b, uint64(i.x))
+	case *tableSize:
+		return appendUnsigned(b, uint64(i.x))
+	case *tableFill:
+		return appendUnsigned(b, uint64(i.x))
+	// Memory Instructions
+	case memoryAccess:
+		m := i.memoryArgument()
+		b = appendUnsigned(b, uint64(m.align))
+		return appendUnsigned(b, uint64(m.offset))
+	case *memorySize, *memoryGrow, *memoryFill:
+		return append(b, 0x00)
+	case *memoryCopy:
+		return append(b, 0x00, 0x00)
+	case *memoryInit:
+		b = appendUnsigned(b, uint64(i.x))
+		return append(b, 0x00)
+	case *dataDrop:
+		return appendUnsigned(b, uint64(i.x))
+	// Numeric Instructions
+	case *int32Const:
+		return appendSigned(b, int64(i.n))
+	case *int64Const:
+		return appendSigned(b, i.n)
+	case *float32Const:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(i.z))
+	case *float64Const:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(i.z))
+	default:
+		return b
+	}
+}
@@ -0,0 +1,131 @@
+package jwasm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A module using every section, element and data segment encoding and most immediate kinds
+const encoderTestModule = "0061736d010000000005016101020301100360000060027f7f017f60017e027d7c021d0303656e760166000003656e760174017001010a03656e760167037e0103030201020404016f00020504010101020613037f00417b0b7d00430100a07f0b7000d2010b071704036164640001036d656d0200037461620101016703000801000936080041000b02000101000102020141040b000103030001040441000b01d2050b056f01d06f0b060241000b7001d0700b077001d2060b0c01030a9301028c0103017f027e017d027f200020016a0d000b034004010e0200010205010b0b0440000b0440050b418080808078427f430000c07f44000000000000f07f2802904e3b01003f004000fc080100fc0900fc0a0000fc0b00fc0c0100fc0d01fc0e0001fc0f00fc1000fc11002500260011010010001c017f1b1ad070d1d2012100220023002400fc00c4bfa70f000b0300010b0b11030041080b026869010121020141000b00000b046e616d65010401000166"
+
+func TestEncodingRoundTrip(t *testing.T) {
+	data, err := hex.DecodeString(encoderTestModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := Parser{}
+	module, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = NewEncoder(&buf).Encode(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, data, buf.Bytes())
+}
+
+// A module as written by linkers, with padded section sizes and a padded call 0
+const paddedTestModule = "0061736d0100000001848080800001600000030201000a8a808080000108001080808080000b"
+
+func TestEncodingRoundTripPreservesPaddedIntegers(t *testing.T) {
+	data, err := hex.DecodeString(paddedTestModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := Parser{}
+	module, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = NewEncoder(&buf).Encode(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, data, buf.Bytes())
+}
+
+func TestParsingKeepsOnlyEncodingsThatAreNotShortest(t *testing.T) {
+	parser := Parser{}
+
+	data, err := hex.DecodeString(encoderTestModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, module.raw)
+
+	data, err = hex.DecodeString(paddedTestModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err = parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the size of the type section is padded, the code section also pads call 0
+	assert.Len(t, module.raw, 2)
+	assert.Equal(t, 5, module.raw[module.TypeSection].sizeWidth)
+	assert.Nil(t, module.raw[module.TypeSection].contents)
+	assert.Len(t, module.raw[module.CodeSection].contents, 10)
+}
+
+func TestEncodingReencodesModifiedSections(t *testing.T) {
+	data, err := hex.DecodeString(paddedTestModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := Parser{}
+	module, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	module.CodeSection.functionCode[0].body = []instruction{&unreachable{}}
+
+	var buf bytes.Buffer
+	err = NewEncoder(&buf).Encode(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The unmodified type section keeps its padded size, the code section is encoded anew
+	assert.Equal(t, "0061736d0100000001848080800001600000030201000a05010300000b", hex.EncodeToString(buf.Bytes()))
+}
+
+func TestEncodingModifiedModule(t *testing.T) {
+	module := &Module{}
+	module.addSection(&TypeSection{[]FunctionType{{ResultType{ValueTypeI32}, ResultType{ValueTypeI32}}}})
+	module.addSection(&FunctionSection{[]uint32{0}})
+	module.addSection(&ExportSection{[]export{{"inc", &exportDescriptionFunc{0}}}})
+	module.addSection(&CodeSection{[]functionCode{{nil, []instruction{&localGet{0}, &int32Const{1}, &int32Add{}}}}})
+
+	var buf bytes.Buffer
+	err := NewEncoder(&buf).Encode(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := Parser{}
+	decoded, err := parser.Parse(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, module.Sections, decoded.Sections)
+}
@@ -0,0 +1,327 @@
+package jwasm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Sentinel errors that classify why decoding a module failed, use errors.Is to test for them.
+var (
+	ErrBadMagic       = errors.New("magic header not detected")
+	ErrBadVersion     = errors.New("unknown binary version")
+	ErrUnexpectedEOF  = errors.New("unexpected end")
+	ErrUnknownSection = errors.New("malformed section id")
+	ErrSectionOrder   = errors.New("unexpected section")
+	// ErrSectionSizeMismatch is returned if the declared size of a section or function body
+	// does not match the size of its decoded contents.
+	ErrSectionSizeMismatch = errors.New("section size mismatch")
+	ErrUnknownOpcode       = errors.New("unknown opcode")
+	ErrMalformedLEB        = errors.New("malformed LEB128 integer")
+	// ErrNestingTooDeep is returned if structured instructions are nested deeper than the
+	// implementation supports, validation reports it as well.
+	ErrNestingTooDeep = errors.New("nesting too deep")
+)
+
+// DecodeError describes where in a binary decoding a module failed.
+type DecodeError struct {
+	// Offset is the absolute position in bytes from the start of the binary at which
+	// decoding failed.
+	Offset int64
+	// SectionId is the id of the section that failed to decode, nil if decoding failed
+	// outside of a section.
+	SectionId *SectionId
+	// FunctionIndex is the index in the function index space of the function whose code
+	// failed to decode, nil if decoding failed outside of the code section.
+	FunctionIndex *uint32
+	Err           error
+}
+
+func (e *DecodeError) Error() string {
+	location := fmt.Sprintf("offset [0x%x]", e.Offset)
+	if e.SectionId != nil {
+		location += fmt.Sprintf(", %s section", e.SectionId)
+	}
+	if e.FunctionIndex != nil {
+		location += fmt.Sprintf(", function [%d]", *e.FunctionIndex)
+	}
+
+	return fmt.Sprintf("decoding failed at %s: %v", location, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error { return e.Err }
+
+// newDecodeError creates a DecodeError at offset, classifying the end of input as ErrUnexpectedEOF.
+func newDecodeError(offset int64, err error) *DecodeError {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		err = fmt.Errorf("%w: %w", ErrUnexpectedEOF, err)
+	}
+
+	return &DecodeError{Offset: offset, Err: err}
+}
+
+// Sentinel errors that classify why assembling a module from the text format failed.
+var (
+	ErrUnexpectedToken   = errors.New("unexpected token")
+	ErrUnknownOperator   = errors.New("unknown operator")
+	ErrUnknownIdentifier = errors.New("unknown identifier")
+	// ErrDuplicateIdentifier is returned if an identifier is bound twice in the same index space.
+	ErrDuplicateIdentifier = errors.New("duplicate identifier")
+	ErrConstantOutOfRange  = errors.New("constant out of range")
+)
+
+// SyntaxError describes where in a text a module failed to assemble.
+type SyntaxError struct {
+	// Line and Column are the 1-based position of the token at which assembling failed.
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line [%d], column [%d]: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *SyntaxError) Unwrap() error { return e.Err }
+
+// Sentinel errors that classify why a module is invalid, their messages follow the ones
+// expected by the specification test suite.
+var (
+	ErrTypeMismatch                = errors.New("type mismatch")
+	ErrUnknownType                 = errors.New("unknown type")
+	ErrUnknownFunction             = errors.New("unknown function")
+	ErrUnknownTable                = errors.New("unknown table")
+	ErrUnknownMemory               = errors.New("unknown memory")
+	ErrUnknownGlobal               = errors.New("unknown global")
+	ErrUnknownLocal                = errors.New("unknown local")
+	ErrUnknownLabel                = errors.New("unknown label")
+	ErrUnknownElementSegment       = errors.New("unknown elem segment")
+	ErrUnknownDataSegment          = errors.New("unknown data segment")
+	ErrImmutableGlobal             = errors.New("global is immutable")
+	ErrAlignmentTooLarge           = errors.New("alignment must not be larger than natural")
+	ErrUndeclaredFunctionReference = errors.New("undeclared function reference")
+	ErrDataCountRequired           = errors.New("data count section required")
+	ErrConstantExpressionRequired  = errors.New("constant expression required")
+	ErrInvalidResultArity          = errors.New("invalid result arity")
+	ErrFunctionCodeMismatch        = errors.New("function and code section have inconsistent lengths")
+	ErrDuplicateExportName         = errors.New("duplicate export name")
+	ErrInvalidStartFunction        = errors.New("start function")
+	ErrLimitsOutOfRange            = errors.New("limits out of range")
+	ErrMultipleMemories            = errors.New("multiple memories")
+	ErrMalformedUTF8               = errors.New("malformed UTF-8 encoding")
+	// ErrTooManyLocals is returned if a function has more locals than the implementation
+	// supports.
+	ErrTooManyLocals = errors.New("too many locals")
+)
+
+// Sentinel errors that classify why the imports of a module cannot be resolved.
+var (
+	ErrUnknownImport          = errors.New("unknown import")
+	ErrIncompatibleImportType = errors.New("incompatible import type")
+)
+
+// ValidationError describes where in a module validation failed.
+type ValidationError struct {
+	// SectionId is the id of the section that holds the invalid expression.
+	SectionId *SectionId
+	// FunctionIndex is the index in the function index space of the invalid function, nil
+	// if validation failed outside of the code section.
+	FunctionIndex *uint32
+	// Offset is the position in bytes of the invalid instruction from the start of the
+	// instructions of the function body or constant expression.
+	Offset int64
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	var location []string
+	if e.SectionId != nil {
+		location = append(location, fmt.Sprintf("%s section", e.SectionId))
+	}
+	if e.FunctionIndex != nil {
+		location = append(location, fmt.Sprintf("function [%d]", *e.FunctionIndex))
+	}
+	location = append(location, fmt.Sprintf("offset [0x%x]", e.Offset))
+
+	return fmt.Sprintf("validation failed at %s: %v", strings.Join(location, ", "), e.Err)
+}
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// sectionValidationError locates err, which wraps a *ValidationError, in the section with
+// the given id and prefixes its cause with the invalid definition.
+func sectionValidationError(id SectionId, definition string, err error) *ValidationError {
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) {
+		validationError = &ValidationError{Err: err}
+	}
+
+	validationError.SectionId = &id
+	if definition != "" {
+		validationError.Err = fmt.Errorf("%s: %w", definition, validationError.Err)
+	}
+	return validationError
+}
+
+// Diagnostic describes an entry of a section that violates a module-level validation rule.
+type Diagnostic struct {
+	SectionId SectionId
+	// Index is the position of the invalid entry in its section.
+	Index uint32
+	Err   error
+}
+
+func (d *Diagnostic) Error() string {
+	return fmt.Sprintf("validation failed at %s section, entry [%d]: %v", d.SectionId, d.Index, d.Err)
+}
+
+func (d *Diagnostic) Unwrap() error { return d.Err }
+
+// Sentinel errors for traps, which abort the execution of a function. Their messages follow
+// the ones expected by the specification test suite.
+var (
+	ErrUnreachable         = errors.New("unreachable")
+	ErrIntegerDivideByZero = errors.New("integer divide by zero")
+	ErrIntegerOverflow     = errors.New("integer overflow")
+	ErrInvalidConversion   = errors.New("invalid conversion to integer")
+	ErrCallStackExhausted  = errors.New("call stack exhausted")
+	// ErrOutOfBoundsMemoryAccess is returned for accesses to bytes beyond the size of a memory.
+	ErrOutOfBoundsMemoryAccess = errors.New("out of bounds memory access")
+	ErrOutOfBoundsTableAccess  = errors.New("out of bounds table access")
+	// ErrUndefinedElement is returned for indirect calls with an index beyond the size of
+	// the table, ErrUninitializedElement for indirect calls of null references.
+	ErrUndefinedElement         = errors.New("undefined element")
+	ErrUninitializedElement     = errors.New("uninitialized element")
+	ErrIndirectCallTypeMismatch = errors.New("indirect call type mismatch")
+	// ErrOutOfFuel is returned if the remaining fuel of a store does not cover the cost of
+	// an instruction.
+	ErrOutOfFuel = errors.New("out of fuel")
+)
+
+// TrapCode classifies the cause of a trap.
+type TrapCode int
+
+const (
+	TrapUnreachable TrapCode = iota
+	TrapIntegerDivideByZero
+	TrapIntegerOverflow
+	TrapInvalidConversion
+	TrapOutOfBoundsMemoryAccess
+	TrapOutOfBoundsTableAccess
+	TrapUndefinedElement
+	TrapUninitializedElement
+	TrapIndirectCallTypeMismatch
+	TrapCallStackExhausted
+	TrapOutOfFuel
+	// TrapHostFunction is the code of traps caused by an error of a host function.
+	TrapHostFunction
+	// TrapPanic is the code of traps caused by a panic of a host function or the interpreter.
+	TrapPanic
+)
+
+// trapErrors holds the sentinel error of each trap code that has one.
+var trapErrors = []error{
+	TrapUnreachable:              ErrUnreachable,
+	TrapIntegerDivideByZero:      ErrIntegerDivideByZero,
+	TrapIntegerOverflow:          ErrIntegerOverflow,
+	TrapInvalidConversion:        ErrInvalidConversion,
+	TrapOutOfBoundsMemoryAccess:  ErrOutOfBoundsMemoryAccess,
+	TrapOutOfBoundsTableAccess:   ErrOutOfBoundsTableAccess,
+	TrapUndefinedElement:         ErrUndefinedElement,
+	TrapUninitializedElement:     ErrUninitializedElement,
+	TrapIndirectCallTypeMismatch: ErrIndirectCallTypeMismatch,
+	TrapCallStackExhausted:       ErrCallStackExhausted,
+	TrapOutOfFuel:                ErrOutOfFuel,
+}
+
+func (c TrapCode) String() string {
+	switch {
+	case c >= 0 && int(c) < len(trapErrors):
+		return trapErrors[c].Error()
+	case c == TrapHostFunction:
+		return "host function"
+	case c == TrapPanic:
+		return "panic"
+	default:
+		return fmt.Sprintf("TrapCode(%d)", int(c))
+	}
+}
+
+// Trap is the error of a function call that trapped.
+// https://webassembly.github.io/spec/core/exec/runtime.html#results
+type Trap struct {
+	Code TrapCode
+	// Backtrace holds the functions that were active when the trap occurred, the innermost
+	// one first. It is empty for traps outside of functions, such as in active segments.
+	Backtrace []TrapFrame
+	Err       error
+}
+
+func (t *Trap) Error() string {
+	if len(t.Backtrace) == 0 {
+		return t.Err.Error()
+	}
+	return fmt.Sprintf("%v in %s", t.Err, t.Backtrace[0])
+}
+
+func (t *Trap) Unwrap() error { return t.Err }
+
+// TrapFrame is a function in the backtrace of a trap. Its names are taken from the name
+// section of its module and are empty if there is none.
+type TrapFrame struct {
+	Module string
+	// FunctionIndex is the index of the function in the function index space of its module.
+	FunctionIndex uint32
+	Name          string
+}
+
+func (f TrapFrame) String() string {
+	s := fmt.Sprintf("function [%d]", f.FunctionIndex)
+	if f.Name != "" {
+		s += fmt.Sprintf(" %q", f.Name)
+	}
+	if f.Module != "" {
+		s += fmt.Sprintf(" of module %q", f.Module)
+	}
+	return s
+}
+
+// panicError is a panic recovered during execution.
+type panicError struct {
+	value any
+}
+
+func (e *panicError) Error() string { return fmt.Sprintf("panic: %v", e.value) }
+
+func (e *panicError) Unwrap() error {
+	err, _ := e.value.(error)
+	return err
+}
+
+// newTrap returns err as a *Trap if it is caused by a trap or a host function, other errors
+// such as the use of unsupported features are returned unchanged.
+func newTrap(err error) error {
+	if t, ok := err.(*Trap); ok {
+		return t
+	}
+
+	var p *panicError
+	if errors.As(err, &p) {
+		return &Trap{Code: TrapPanic, Err: err}
+	}
+
+	// Errors of host functions may wrap the sentinels of traps, such as the ones of Memory,
+	// but they trap because the host function failed
+	var h *hostError
+	if errors.As(err, &h) {
+		return &Trap{Code: TrapHostFunction, Err: err}
+	}
+
+	for code, sentinel := range trapErrors {
+		if errors.Is(err, sentinel) {
+			return &Trap{Code: TrapCode(code), Err: err}
+		}
+	}
+	return err
+}
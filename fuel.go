@@ -0,0 +1,68 @@
+package jwasm
+
+import (
+	"fmt"
+	"math"
+)
+
+// SetFuel enables fuel metering for the store and sets its remaining fuel, which limits the
+// execution of its functions deterministically. Each instruction that a call executes,
+// including the start function, consumes fuel, calls trap with TrapOutOfFuel before an
+// instruction that costs more than the fuel that remains. The remaining fuel is kept when
+// a call traps, so that more can be added before the next call. Host functions consume no
+// fuel besides the call and constant expressions evaluated during instantiation none at all.
+func (s *Store) SetFuel(fuel uint64) {
+	s.vm.metered = true
+	s.vm.fuel = fuel
+}
+
+// AddFuel enables fuel metering for the store and adds fuel to its remaining fuel, which
+// saturates at the maximum uint64.
+func (s *Store) AddFuel(fuel uint64) {
+	s.vm.metered = true
+	if s.vm.fuel > math.MaxUint64-fuel {
+		s.vm.fuel = math.MaxUint64
+		return
+	}
+	s.vm.fuel += fuel
+}
+
+// Fuel returns the remaining fuel of the store, ok is false if fuel metering is disabled.
+func (s *Store) Fuel() (fuel uint64, ok bool) {
+	return s.vm.fuel, s.vm.metered
+}
+
+// SetFuelCosts sets the fuel that executing an instruction consumes by its name in the text
+// format, such as "i32.add". Instructions without a cost consume 1.
+func (s *Store) SetFuelCosts(costs map[string]uint64) error {
+	result := make(map[opcode]uint64)
+	for name, cost := range costs {
+		op, ok := textOpcodes[name]
+		if !ok {
+			return fmt.Errorf("setting fuel cost of [%s] failed: %w", name, ErrUnknownOperator)
+		}
+
+		result[op] = cost
+		// The typed select shares its name with the untyped one
+		if op == 0x1B {
+			result[0x1C] = cost
+		}
+	}
+
+	s.vm.costs = result
+	return nil
+}
+
+// consume subtracts the cost of the instruction op from the remaining fuel.
+func (vm *VM) consume(op opcode) error {
+	cost, ok := vm.costs[op]
+	if !ok {
+		cost = 1
+	}
+
+	if cost > vm.fuel {
+		return ErrOutOfFuel
+	}
+	vm.fuel -= cost
+	return nil
+}
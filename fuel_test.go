@@ -0,0 +1,98 @@
+package jwasm
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallsConsumeFuel(t *testing.T) {
+	store := NewStore()
+	inst, err := (&Linker{}).Instantiate(context.Background(), store, assemble(t, `(module
+  (func (export "add") (param i32 i32) (result i32) (i32.add (local.get 0) (local.get 1)))
+  (func (export "spin") (loop (br 0))))`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := store.Fuel()
+	assert.False(t, ok)
+
+	store.SetFuel(5)
+	_, err = inst.Call("add", int32(1), int32(2))
+	if assert.NoError(t, err) {
+		fuel, ok := store.Fuel()
+		assert.True(t, ok)
+		assert.Equal(t, uint64(2), fuel)
+	}
+
+	_, err = inst.Call("add", int32(1), int32(2))
+	var trap *Trap
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapOutOfFuel, trap.Code)
+		assert.ErrorIs(t, err, ErrOutOfFuel)
+	}
+
+	fuel, _ := store.Fuel()
+	assert.Equal(t, uint64(0), fuel)
+
+	store.AddFuel(3)
+	results, err := inst.Call("add", int32(1), int32(2))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{int32(3)}, results)
+	}
+
+	store.SetFuel(1000)
+	_, err = inst.Call("spin")
+	assert.ErrorIs(t, err, ErrOutOfFuel)
+
+	store.AddFuel(math.MaxUint64)
+	fuel, _ = store.Fuel()
+	assert.Equal(t, uint64(math.MaxUint64), fuel)
+}
+
+func TestSettingFuelCosts(t *testing.T) {
+	store := NewStore()
+	inst, err := (&Linker{}).Instantiate(context.Background(), store, assemble(t, `(func (export "f") (result i32)
+  (i32.mul (i32.const 2) (i32.const 3)))`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.SetFuelCosts(map[string]uint64{"i32.mul": 10, "i32.const": 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	store.SetFuel(15)
+	_, err = inst.Call("f")
+	if assert.NoError(t, err) {
+		fuel, _ := store.Fuel()
+		assert.Equal(t, uint64(5), fuel)
+	}
+
+	assert.ErrorIs(t, store.SetFuelCosts(map[string]uint64{"i32.frobnicate": 1}), ErrUnknownOperator)
+}
+
+func TestInstantiatingConsumesFuelOnlyForTheStartFunction(t *testing.T) {
+	store := NewStore()
+	store.SetFuel(0)
+
+	_, err := (&Linker{}).Instantiate(context.Background(), store, assemble(t, `(module
+  (table 1 funcref)
+  (memory 1)
+  (global i32 (i32.const 1))
+  (elem (i32.const 0) $f)
+  (data (i32.const 0) "a")
+  (func $f))`))
+	assert.NoError(t, err)
+
+	_, err = (&Linker{}).Instantiate(context.Background(), store, assemble(t, `(module
+  (func $start nop)
+  (start $start))`))
+	var trap *Trap
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapOutOfFuel, trap.Code)
+	}
+}
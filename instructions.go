@@ -1,14 +1,267 @@
 package jwasm
 
 import (
-	"encoding/binary"
+	"bytes"
 	"fmt"
 	"io"
+	"math"
 )
 
+// instruction is the interface of all instructions, the interpreter executes them by
+// switching on their type.
 type instruction interface {
-	// Call(vm *VM)
 	instruction()
+	opcode() opcode
+}
+
+// opcode identifies an instruction. Instructions with a 0xFC prefix byte are
+// stored with the prefix in the high byte and their u32 sub-opcode in the low byte.
+type opcode uint16
+
+// maxNestingDepth limits how deeply blocks, loops and ifs can be nested. Validation and
+// execution walk nested instructions recursively, so that deeper nesting could exhaust the
+// goroutine stack.
+const maxNestingDepth = 10000
+
+// Control Instructions
+// https://webassembly.github.io/spec/core/binary/instructions.html#control-instructions
+
+type unreachable struct{}
+type nop struct{}
+type returnInstruction struct{}
+
+func (*unreachable) instruction()       {}
+func (*nop) instruction()               {}
+func (*returnInstruction) instruction() {}
+
+func (*unreachable) opcode() opcode       { return 0x00 }
+func (*nop) opcode() opcode               { return 0x01 }
+func (*returnInstruction) opcode() opcode { return 0x0F }
+
+// https://webassembly.github.io/spec/core/syntax/instructions.html#syntax-blocktype
+type blockType interface {
+	blockType()
+}
+
+type blockTypeEmpty struct{}
+
+type blockTypeValue struct {
+	t ValueType
+}
+
+type blockTypeIndex struct {
+	x typeIndex
+}
+
+func (*blockTypeEmpty) blockType() {}
+func (*blockTypeValue) blockType() {}
+func (*blockTypeIndex) blockType() {}
+
+func parseBlockType(r io.Reader) (blockType, error) {
+	// https://webassembly.github.io/spec/core/binary/instructions.html#binary-blocktype
+	//
+	// A block type is encoded as the byte 0x40 for the empty type, as a single value
+	// type, or as a type index encoded as a positive signed integer (s33), so that its
+	// first byte cannot collide with either of the other encodings.
+
+	b, err := readByte(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading block type failed: %w", err)
+	}
+
+	if b == 0x40 {
+		return &blockTypeEmpty{}, nil
+	}
+
+	if t, err := valueTypeOf(b); err == nil {
+		return &blockTypeValue{t}, nil
+	}
+
+	x, err := readSignedFrom(byteReader(r), b, 33)
+	if err != nil {
+		return nil, fmt.Errorf("reading block type index failed: %w", err)
+	}
+
+	if x < 0 {
+		return nil, fmt.Errorf("block type index must not be negative: %d", x)
+	}
+
+	return &blockTypeIndex{typeIndex(x)}, nil
+}
+
+type block struct {
+	bt           blockType
+	instructions []instruction
+}
+
+func (*block) instruction()   {}
+func (*block) opcode() opcode { return 0x02 }
+
+type loop struct {
+	bt           blockType
+	instructions []instruction
+}
+
+func (*loop) instruction()   {}
+func (*loop) opcode() opcode { return 0x03 }
+
+type ifInstruction struct {
+	bt           blockType
+	instructions []instruction
+	// elseInstructions is nil if the else branch was omitted.
+	elseInstructions []instruction
+}
+
+func (*ifInstruction) instruction()   {}
+func (*ifInstruction) opcode() opcode { return 0x04 }
+
+type br struct{ l labelIndex }
+
+func (*br) instruction()   {}
+func (*br) opcode() opcode { return 0x0C }
+
+func parseBr(r io.Reader) (*br, error) {
+	l, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading l for Br failed: %w", err)
+	}
+	return &br{labelIndex(l)}, nil
+}
+
+type brIf struct{ l labelIndex }
+
+func (*brIf) instruction()   {}
+func (*brIf) opcode() opcode { return 0x0D }
+
+func parseBrIf(r io.Reader) (*brIf, error) {
+	l, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading l for BrIf failed: %w", err)
+	}
+	return &brIf{labelIndex(l)}, nil
+}
+
+type brTable struct {
+	l  []labelIndex
+	lN labelIndex
+}
+
+func (*brTable) instruction()   {}
+func (*brTable) opcode() opcode { return 0x0E }
+
+func parseBrTable(r io.Reader) (*brTable, error) {
+	numLabels, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size for BrTable failed: %w", err)
+	}
+
+	var labels []labelIndex
+	for i := 0; i < int(numLabels); i++ {
+		l, err := ReadUint32(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading l for BrTable failed: %w", err)
+		}
+		labels = append(labels, labelIndex(l))
+	}
+
+	lN, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading lN for BrTable failed: %w", err)
+	}
+
+	return &brTable{labels, labelIndex(lN)}, nil
+}
+
+type call struct{ x functionIndex }
+
+func (*call) instruction()   {}
+func (*call) opcode() opcode { return 0x10 }
+
+func parseCall(r io.Reader) (*call, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for Call failed: %w", err)
+	}
+	return &call{functionIndex(x)}, nil
+}
+
+type callIndirect struct {
+	y typeIndex
+	x tableIndex
+}
+
+func (*callIndirect) instruction()   {}
+func (*callIndirect) opcode() opcode { return 0x11 }
+
+func parseCallIndirect(r io.Reader) (*callIndirect, error) {
+	y, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading y for CallIndirect failed: %w", err)
+	}
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for CallIndirect failed: %w", err)
+	}
+	return &callIndirect{typeIndex(y), tableIndex(x)}, nil
+}
+
+// Reference Instructions
+// https://webassembly.github.io/spec/core/binary/instructions.html#reference-instructions
+
+type refNull struct{ t *referenceType }
+
+func (*refNull) instruction()   {}
+func (*refNull) opcode() opcode { return 0xD0 }
+
+func parseRefNull(r io.Reader) (*refNull, error) {
+	t, err := parseReferenceType(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading t for RefNull failed: %w", err)
+	}
+	return &refNull{t}, nil
+}
+
+type refIsNull struct{}
+
+func (*refIsNull) instruction()   {}
+func (*refIsNull) opcode() opcode { return 0xD1 }
+
+type refFunc struct{ x functionIndex }
+
+func (*refFunc) instruction()   {}
+func (*refFunc) opcode() opcode { return 0xD2 }
+
+func parseRefFunc(r io.Reader) (*refFunc, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for RefFunc failed: %w", err)
+	}
+	return &refFunc{functionIndex(x)}, nil
+}
+
+// Parametric Instructions
+// https://webassembly.github.io/spec/core/binary/instructions.html#parametric-instructions
+
+type drop struct{}
+type selectInstruction struct{}
+
+func (*drop) instruction()              {}
+func (*selectInstruction) instruction() {}
+
+func (*drop) opcode() opcode              { return 0x1A }
+func (*selectInstruction) opcode() opcode { return 0x1B }
+
+type selectTyped struct{ t []ValueType }
+
+func (*selectTyped) instruction()   {}
+func (*selectTyped) opcode() opcode { return 0x1C }
+
+func parseSelectTyped(r io.Reader) (*selectTyped, error) {
+	t, err := parseResultType(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading t for SelectTyped failed: %w", err)
+	}
+	return &selectTyped{t}, nil
 }
 
 // Variable Instructions
@@ -16,7 +269,8 @@ type instruction interface {
 
 type localGet struct{ x localIndex }
 
-func (*localGet) instruction() {}
+func (*localGet) instruction()   {}
+func (*localGet) opcode() opcode { return 0x20 }
 
 func parseLocalGet(r io.Reader) (*localGet, error) {
 	x, err := ReadUint32(r)
@@ -26,58 +280,1644 @@ func parseLocalGet(r io.Reader) (*localGet, error) {
 	return &localGet{localIndex(x)}, nil
 }
 
+type localSet struct{ x localIndex }
+
+func (*localSet) instruction()   {}
+func (*localSet) opcode() opcode { return 0x21 }
+
+func parseLocalSet(r io.Reader) (*localSet, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for LocalSet failed: %w", err)
+	}
+	return &localSet{localIndex(x)}, nil
+}
+
+type localTee struct{ x localIndex }
+
+func (*localTee) instruction()   {}
+func (*localTee) opcode() opcode { return 0x22 }
+
+func parseLocalTee(r io.Reader) (*localTee, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for LocalTee failed: %w", err)
+	}
+	return &localTee{localIndex(x)}, nil
+}
+
+type globalGet struct{ x globalIndex }
+
+func (*globalGet) instruction()   {}
+func (*globalGet) opcode() opcode { return 0x23 }
+
+func parseGlobalGet(r io.Reader) (*globalGet, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for GlobalGet failed: %w", err)
+	}
+	return &globalGet{globalIndex(x)}, nil
+}
+
+type globalSet struct{ x globalIndex }
+
+func (*globalSet) instruction()   {}
+func (*globalSet) opcode() opcode { return 0x24 }
+
+func parseGlobalSet(r io.Reader) (*globalSet, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for GlobalSet failed: %w", err)
+	}
+	return &globalSet{globalIndex(x)}, nil
+}
+
+// Table Instructions
+// https://webassembly.github.io/spec/core/binary/instructions.html#table-instructions
+
+type tableGet struct{ x tableIndex }
+
+func (*tableGet) instruction()   {}
+func (*tableGet) opcode() opcode { return 0x25 }
+
+func parseTableGet(r io.Reader) (*tableGet, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for TableGet failed: %w", err)
+	}
+	return &tableGet{tableIndex(x)}, nil
+}
+
+type tableSet struct{ x tableIndex }
+
+func (*tableSet) instruction()   {}
+func (*tableSet) opcode() opcode { return 0x26 }
+
+func parseTableSet(r io.Reader) (*tableSet, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for TableSet failed: %w", err)
+	}
+	return &tableSet{tableIndex(x)}, nil
+}
+
+type tableInit struct {
+	y elementIndex
+	x tableIndex
+}
+
+func (*tableInit) instruction()   {}
+func (*tableInit) opcode() opcode { return 0xFC0C }
+
+func parseTableInit(r io.Reader) (*tableInit, error) {
+	y, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading y for TableInit failed: %w", err)
+	}
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for TableInit failed: %w", err)
+	}
+	return &tableInit{elementIndex(y), tableIndex(x)}, nil
+}
+
+type elemDrop struct{ x elementIndex }
+
+func (*elemDrop) instruction()   {}
+func (*elemDrop) opcode() opcode { return 0xFC0D }
+
+func parseElemDrop(r io.Reader) (*elemDrop, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for ElemDrop failed: %w", err)
+	}
+	return &elemDrop{elementIndex(x)}, nil
+}
+
+type tableCopy struct {
+	x tableIndex
+	y tableIndex
+}
+
+func (*tableCopy) instruction()   {}
+func (*tableCopy) opcode() opcode { return 0xFC0E }
+
+func parseTableCopy(r io.Reader) (*tableCopy, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for TableCopy failed: %w", err)
+	}
+	y, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading y for TableCopy failed: %w", err)
+	}
+	return &tableCopy{tableIndex(x), tableIndex(y)}, nil
+}
+
+type tableGrow struct{ x tableIndex }
+
+func (*tableGrow) instruction()   {}
+func (*tableGrow) opcode() opcode { return 0xFC0F }
+
+func parseTableGrow(r io.Reader) (*tableGrow, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for TableGrow failed: %w", err)
+	}
+	return &tableGrow{tableIndex(x)}, nil
+}
+
+type tableSize struct{ x tableIndex }
+
+func (*tableSize) instruction()   {}
+func (*tableSize) opcode() opcode { return 0xFC10 }
+
+func parseTableSize(r io.Reader) (*tableSize, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for TableSize failed: %w", err)
+	}
+	return &tableSize{tableIndex(x)}, nil
+}
+
+type tableFill struct{ x tableIndex }
+
+func (*tableFill) instruction()   {}
+func (*tableFill) opcode() opcode { return 0xFC11 }
+
+func parseTableFill(r io.Reader) (*tableFill, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for TableFill failed: %w", err)
+	}
+	return &tableFill{tableIndex(x)}, nil
+}
+
+// Memory Instructions
+// https://webassembly.github.io/spec/core/binary/instructions.html#memory-instructions
+
+// memoryAccess is implemented by all load and store instructions.
+type memoryAccess interface {
+	instruction
+	memoryArgument() memoryArgument
+}
+
+// https://webassembly.github.io/spec/core/syntax/instructions.html#syntax-memarg
+type memoryArgument struct {
+	align  uint32
+	offset uint32
+}
+
+func parseMemoryArgument(r io.Reader) (memoryArgument, error) {
+	align, err := ReadUint32(r)
+	if err != nil {
+		return memoryArgument{}, fmt.Errorf("reading memarg align failed: %w", err)
+	}
+
+	offset, err := ReadUint32(r)
+	if err != nil {
+		return memoryArgument{}, fmt.Errorf("reading memarg offset failed: %w", err)
+	}
+
+	return memoryArgument{align, offset}, nil
+}
+
+type int32Load struct{ m memoryArgument }
+type int64Load struct{ m memoryArgument }
+type float32Load struct{ m memoryArgument }
+type float64Load struct{ m memoryArgument }
+type int32Load8S struct{ m memoryArgument }
+type int32Load8U struct{ m memoryArgument }
+type int32Load16S struct{ m memoryArgument }
+type int32Load16U struct{ m memoryArgument }
+type int64Load8S struct{ m memoryArgument }
+type int64Load8U struct{ m memoryArgument }
+type int64Load16S struct{ m memoryArgument }
+type int64Load16U struct{ m memoryArgument }
+type int64Load32S struct{ m memoryArgument }
+type int64Load32U struct{ m memoryArgument }
+type int32Store struct{ m memoryArgument }
+type int64Store struct{ m memoryArgument }
+type float32Store struct{ m memoryArgument }
+type float64Store struct{ m memoryArgument }
+type int32Store8 struct{ m memoryArgument }
+type int32Store16 struct{ m memoryArgument }
+type int64Store8 struct{ m memoryArgument }
+type int64Store16 struct{ m memoryArgument }
+type int64Store32 struct{ m memoryArgument }
+
+func (*int32Load) instruction()    {}
+func (*int64Load) instruction()    {}
+func (*float32Load) instruction()  {}
+func (*float64Load) instruction()  {}
+func (*int32Load8S) instruction()  {}
+func (*int32Load8U) instruction()  {}
+func (*int32Load16S) instruction() {}
+func (*int32Load16U) instruction() {}
+func (*int64Load8S) instruction()  {}
+func (*int64Load8U) instruction()  {}
+func (*int64Load16S) instruction() {}
+func (*int64Load16U) instruction() {}
+func (*int64Load32S) instruction() {}
+func (*int64Load32U) instruction() {}
+func (*int32Store) instruction()   {}
+func (*int64Store) instruction()   {}
+func (*float32Store) instruction() {}
+func (*float64Store) instruction() {}
+func (*int32Store8) instruction()  {}
+func (*int32Store16) instruction() {}
+func (*int64Store8) instruction()  {}
+func (*int64Store16) instruction() {}
+func (*int64Store32) instruction() {}
+
+func (*int32Load) opcode() opcode    { return 0x28 }
+func (*int64Load) opcode() opcode    { return 0x29 }
+func (*float32Load) opcode() opcode  { return 0x2A }
+func (*float64Load) opcode() opcode  { return 0x2B }
+func (*int32Load8S) opcode() opcode  { return 0x2C }
+func (*int32Load8U) opcode() opcode  { return 0x2D }
+func (*int32Load16S) opcode() opcode { return 0x2E }
+func (*int32Load16U) opcode() opcode { return 0x2F }
+func (*int64Load8S) opcode() opcode  { return 0x30 }
+func (*int64Load8U) opcode() opcode  { return 0x31 }
+func (*int64Load16S) opcode() opcode { return 0x32 }
+func (*int64Load16U) opcode() opcode { return 0x33 }
+func (*int64Load32S) opcode() opcode { return 0x34 }
+func (*int64Load32U) opcode() opcode { return 0x35 }
+func (*int32Store) opcode() opcode   { return 0x36 }
+func (*int64Store) opcode() opcode   { return 0x37 }
+func (*float32Store) opcode() opcode { return 0x38 }
+func (*float64Store) opcode() opcode { return 0x39 }
+func (*int32Store8) opcode() opcode  { return 0x3A }
+func (*int32Store16) opcode() opcode { return 0x3B }
+func (*int64Store8) opcode() opcode  { return 0x3C }
+func (*int64Store16) opcode() opcode { return 0x3D }
+func (*int64Store32) opcode() opcode { return 0x3E }
+
+func (i *int32Load) memoryArgument() memoryArgument    { return i.m }
+func (i *int64Load) memoryArgument() memoryArgument    { return i.m }
+func (i *float32Load) memoryArgument() memoryArgument  { return i.m }
+func (i *float64Load) memoryArgument() memoryArgument  { return i.m }
+func (i *int32Load8S) memoryArgument() memoryArgument  { return i.m }
+func (i *int32Load8U) memoryArgument() memoryArgument  { return i.m }
+func (i *int32Load16S) memoryArgument() memoryArgument { return i.m }
+func (i *int32Load16U) memoryArgument() memoryArgument { return i.m }
+func (i *int64Load8S) memoryArgument() memoryArgument  { return i.m }
+func (i *int64Load8U) memoryArgument() memoryArgument  { return i.m }
+func (i *int64Load16S) memoryArgument() memoryArgument { return i.m }
+func (i *int64Load16U) memoryArgument() memoryArgument { return i.m }
+func (i *int64Load32S) memoryArgument() memoryArgument { return i.m }
+func (i *int64Load32U) memoryArgument() memoryArgument { return i.m }
+func (i *int32Store) memoryArgument() memoryArgument   { return i.m }
+func (i *int64Store) memoryArgument() memoryArgument   { return i.m }
+func (i *float32Store) memoryArgument() memoryArgument { return i.m }
+func (i *float64Store) memoryArgument() memoryArgument { return i.m }
+func (i *int32Store8) memoryArgument() memoryArgument  { return i.m }
+func (i *int32Store16) memoryArgument() memoryArgument { return i.m }
+func (i *int64Store8) memoryArgument() memoryArgument  { return i.m }
+func (i *int64Store16) memoryArgument() memoryArgument { return i.m }
+func (i *int64Store32) memoryArgument() memoryArgument { return i.m }
+
+func parseMemoryInstruction(r io.Reader, opcode byte) (instruction, error) {
+	m, err := parseMemoryArgument(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMemoryInstruction(opcode, m)
+}
+
+// newMemoryInstruction creates the load or store instruction with the given opcode.
+func newMemoryInstruction(opcode byte, m memoryArgument) (instruction, error) {
+	switch opcode {
+	case 0x28:
+		return &int32Load{m}, nil
+	case 0x29:
+		return &int64Load{m}, nil
+	case 0x2A:
+		return &float32Load{m}, nil
+	case 0x2B:
+		return &float64Load{m}, nil
+	case 0x2C:
+		return &int32Load8S{m}, nil
+	case 0x2D:
+		return &int32Load8U{m}, nil
+	case 0x2E:
+		return &int32Load16S{m}, nil
+	case 0x2F:
+		return &int32Load16U{m}, nil
+	case 0x30:
+		return &int64Load8S{m}, nil
+	case 0x31:
+		return &int64Load8U{m}, nil
+	case 0x32:
+		return &int64Load16S{m}, nil
+	case 0x33:
+		return &int64Load16U{m}, nil
+	case 0x34:
+		return &int64Load32S{m}, nil
+	case 0x35:
+		return &int64Load32U{m}, nil
+	case 0x36:
+		return &int32Store{m}, nil
+	case 0x37:
+		return &int64Store{m}, nil
+	case 0x38:
+		return &float32Store{m}, nil
+	case 0x39:
+		return &float64Store{m}, nil
+	case 0x3A:
+		return &int32Store8{m}, nil
+	case 0x3B:
+		return &int32Store16{m}, nil
+	case 0x3C:
+		return &int64Store8{m}, nil
+	case 0x3D:
+		return &int64Store16{m}, nil
+	case 0x3E:
+		return &int64Store32{m}, nil
+	default:
+		return nil, fmt.Errorf("parsing memory instruction failed, %w: [%#X]", ErrUnknownOpcode, opcode)
+	}
+}
+
+// The memory index of memory.size, memory.grow, memory.init, memory.copy and memory.fill
+// is encoded as a single zero byte, it is reserved for future extensions.
+func parseReservedZeroByte(r io.Reader) error {
+	b, err := readByte(r)
+	if err != nil {
+		return fmt.Errorf("reading reserved byte failed: %w", err)
+	}
+
+	if b != 0x00 {
+		return fmt.Errorf("zero byte expected, got [0x%x]", b)
+	}
+
+	return nil
+}
+
+type memorySize struct{}
+type memoryGrow struct{}
+type memoryCopy struct{}
+type memoryFill struct{}
+
+func (*memorySize) instruction() {}
+func (*memoryGrow) instruction() {}
+func (*memoryCopy) instruction() {}
+func (*memoryFill) instruction() {}
+
+func (*memorySize) opcode() opcode { return 0x3F }
+func (*memoryGrow) opcode() opcode { return 0x40 }
+func (*memoryCopy) opcode() opcode { return 0xFC0A }
+func (*memoryFill) opcode() opcode { return 0xFC0B }
+
+type memoryInit struct{ x dataIndex }
+
+func (*memoryInit) instruction()   {}
+func (*memoryInit) opcode() opcode { return 0xFC08 }
+
+func parseMemoryInit(r io.Reader) (*memoryInit, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for MemoryInit failed: %w", err)
+	}
+	err = parseReservedZeroByte(r)
+	if err != nil {
+		return nil, err
+	}
+	return &memoryInit{dataIndex(x)}, nil
+}
+
+type dataDrop struct{ x dataIndex }
+
+func (*dataDrop) instruction()   {}
+func (*dataDrop) opcode() opcode { return 0xFC09 }
+
+func parseDataDrop(r io.Reader) (*dataDrop, error) {
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading x for DataDrop failed: %w", err)
+	}
+	return &dataDrop{dataIndex(x)}, nil
+}
+
 // Numeric Instructions
 // https://webassembly.github.io/spec/core/binary/instructions.html#numeric-instructions
 
+type int32Const struct{ n int32 }
+
+func (*int32Const) instruction()   {}
+func (*int32Const) opcode() opcode { return 0x41 }
+
+func parseInt32Const(r io.Reader) (*int32Const, error) {
+	n, err := ReadInt32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading n for Int32Const failed: %w", err)
+	}
+	return &int32Const{n}, nil
+}
+
+type int64Const struct{ n int64 }
+
+func (*int64Const) instruction()   {}
+func (*int64Const) opcode() opcode { return 0x42 }
+
+func parseInt64Const(r io.Reader) (*int64Const, error) {
+	n, err := ReadInt64(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading n for Int64Const failed: %w", err)
+	}
+	return &int64Const{n}, nil
+}
+
+type float32Const struct{ z float32 }
+
+func (*float32Const) instruction()   {}
+func (*float32Const) opcode() opcode { return 0x43 }
+
+func parseFloat32Const(r io.Reader) (*float32Const, error) {
+	// https://webassembly.github.io/spec/core/binary/values.html#floating-point
+	bits, err := readLittleEndian(r, 4)
+	if err != nil {
+		return nil, fmt.Errorf("reading z for Float32Const failed: %w", err)
+	}
+	return &float32Const{math.Float32frombits(uint32(bits))}, nil
+}
+
+type float64Const struct{ z float64 }
+
+func (*float64Const) instruction()   {}
+func (*float64Const) opcode() opcode { return 0x44 }
+
+func parseFloat64Const(r io.Reader) (*float64Const, error) {
+	// https://webassembly.github.io/spec/core/binary/values.html#floating-point
+	bits, err := readLittleEndian(r, 8)
+	if err != nil {
+		return nil, fmt.Errorf("reading z for Float64Const failed: %w", err)
+	}
+	return &float64Const{math.Float64frombits(uint64(bits))}, nil
+}
+
+type int32Eqz struct{}
+type int32Eq struct{}
+type int32Ne struct{}
+type int32LtS struct{}
+type int32LtU struct{}
+type int32GtS struct{}
+type int32GtU struct{}
+type int32LeS struct{}
+type int32LeU struct{}
+type int32GeS struct{}
+type int32GeU struct{}
+type int64Eqz struct{}
+type int64Eq struct{}
+type int64Ne struct{}
+type int64LtS struct{}
+type int64LtU struct{}
+type int64GtS struct{}
+type int64GtU struct{}
+type int64LeS struct{}
+type int64LeU struct{}
+type int64GeS struct{}
+type int64GeU struct{}
+type float32Eq struct{}
+type float32Ne struct{}
+type float32Lt struct{}
+type float32Gt struct{}
+type float32Le struct{}
+type float32Ge struct{}
+type float64Eq struct{}
+type float64Ne struct{}
+type float64Lt struct{}
+type float64Gt struct{}
+type float64Le struct{}
+type float64Ge struct{}
+type int32Clz struct{}
+type int32Ctz struct{}
+type int32Popcnt struct{}
 type int32Add struct{}
+type int32Sub struct{}
+type int32Mul struct{}
+type int32DivS struct{}
+type int32DivU struct{}
+type int32RemS struct{}
+type int32RemU struct{}
+type int32And struct{}
+type int32Or struct{}
+type int32Xor struct{}
+type int32Shl struct{}
+type int32ShrS struct{}
+type int32ShrU struct{}
+type int32Rotl struct{}
+type int32Rotr struct{}
+type int64Clz struct{}
+type int64Ctz struct{}
+type int64Popcnt struct{}
+type int64Add struct{}
+type int64Sub struct{}
+type int64Mul struct{}
+type int64DivS struct{}
+type int64DivU struct{}
+type int64RemS struct{}
+type int64RemU struct{}
+type int64And struct{}
+type int64Or struct{}
+type int64Xor struct{}
+type int64Shl struct{}
+type int64ShrS struct{}
+type int64ShrU struct{}
+type int64Rotl struct{}
+type int64Rotr struct{}
+type float32Abs struct{}
+type float32Neg struct{}
+type float32Ceil struct{}
+type float32Floor struct{}
+type float32Trunc struct{}
+type float32Nearest struct{}
+type float32Sqrt struct{}
+type float32Add struct{}
+type float32Sub struct{}
+type float32Mul struct{}
+type float32Div struct{}
+type float32Min struct{}
+type float32Max struct{}
+type float32Copysign struct{}
+type float64Abs struct{}
+type float64Neg struct{}
+type float64Ceil struct{}
+type float64Floor struct{}
+type float64Trunc struct{}
+type float64Nearest struct{}
+type float64Sqrt struct{}
+type float64Add struct{}
+type float64Sub struct{}
+type float64Mul struct{}
+type float64Div struct{}
+type float64Min struct{}
+type float64Max struct{}
+type float64Copysign struct{}
+type int32WrapInt64 struct{}
+type int32TruncFloat32S struct{}
+type int32TruncFloat32U struct{}
+type int32TruncFloat64S struct{}
+type int32TruncFloat64U struct{}
+type int64ExtendInt32S struct{}
+type int64ExtendInt32U struct{}
+type int64TruncFloat32S struct{}
+type int64TruncFloat32U struct{}
+type int64TruncFloat64S struct{}
+type int64TruncFloat64U struct{}
+type float32ConvertInt32S struct{}
+type float32ConvertInt32U struct{}
+type float32ConvertInt64S struct{}
+type float32ConvertInt64U struct{}
+type float32DemoteFloat64 struct{}
+type float64ConvertInt32S struct{}
+type float64ConvertInt32U struct{}
+type float64ConvertInt64S struct{}
+type float64ConvertInt64U struct{}
+type float64PromoteFloat32 struct{}
+type int32ReinterpretFloat32 struct{}
+type int64ReinterpretFloat64 struct{}
+type float32ReinterpretInt32 struct{}
+type float64ReinterpretInt64 struct{}
+type int32Extend8S struct{}
+type int32Extend16S struct{}
+type int64Extend8S struct{}
+type int64Extend16S struct{}
+type int64Extend32S struct{}
+type int32TruncSatFloat32S struct{}
+type int32TruncSatFloat32U struct{}
+type int32TruncSatFloat64S struct{}
+type int32TruncSatFloat64U struct{}
+type int64TruncSatFloat32S struct{}
+type int64TruncSatFloat32U struct{}
+type int64TruncSatFloat64S struct{}
+type int64TruncSatFloat64U struct{}
 
-func (*int32Add) instruction() {}
+func (*int32Eqz) instruction()                {}
+func (*int32Eq) instruction()                 {}
+func (*int32Ne) instruction()                 {}
+func (*int32LtS) instruction()                {}
+func (*int32LtU) instruction()                {}
+func (*int32GtS) instruction()                {}
+func (*int32GtU) instruction()                {}
+func (*int32LeS) instruction()                {}
+func (*int32LeU) instruction()                {}
+func (*int32GeS) instruction()                {}
+func (*int32GeU) instruction()                {}
+func (*int64Eqz) instruction()                {}
+func (*int64Eq) instruction()                 {}
+func (*int64Ne) instruction()                 {}
+func (*int64LtS) instruction()                {}
+func (*int64LtU) instruction()                {}
+func (*int64GtS) instruction()                {}
+func (*int64GtU) instruction()                {}
+func (*int64LeS) instruction()                {}
+func (*int64LeU) instruction()                {}
+func (*int64GeS) instruction()                {}
+func (*int64GeU) instruction()                {}
+func (*float32Eq) instruction()               {}
+func (*float32Ne) instruction()               {}
+func (*float32Lt) instruction()               {}
+func (*float32Gt) instruction()               {}
+func (*float32Le) instruction()               {}
+func (*float32Ge) instruction()               {}
+func (*float64Eq) instruction()               {}
+func (*float64Ne) instruction()               {}
+func (*float64Lt) instruction()               {}
+func (*float64Gt) instruction()               {}
+func (*float64Le) instruction()               {}
+func (*float64Ge) instruction()               {}
+func (*int32Clz) instruction()                {}
+func (*int32Ctz) instruction()                {}
+func (*int32Popcnt) instruction()             {}
+func (*int32Add) instruction()                {}
+func (*int32Sub) instruction()                {}
+func (*int32Mul) instruction()                {}
+func (*int32DivS) instruction()               {}
+func (*int32DivU) instruction()               {}
+func (*int32RemS) instruction()               {}
+func (*int32RemU) instruction()               {}
+func (*int32And) instruction()                {}
+func (*int32Or) instruction()                 {}
+func (*int32Xor) instruction()                {}
+func (*int32Shl) instruction()                {}
+func (*int32ShrS) instruction()               {}
+func (*int32ShrU) instruction()               {}
+func (*int32Rotl) instruction()               {}
+func (*int32Rotr) instruction()               {}
+func (*int64Clz) instruction()                {}
+func (*int64Ctz) instruction()                {}
+func (*int64Popcnt) instruction()             {}
+func (*int64Add) instruction()                {}
+func (*int64Sub) instruction()                {}
+func (*int64Mul) instruction()                {}
+func (*int64DivS) instruction()               {}
+func (*int64DivU) instruction()               {}
+func (*int64RemS) instruction()               {}
+func (*int64RemU) instruction()               {}
+func (*int64And) instruction()                {}
+func (*int64Or) instruction()                 {}
+func (*int64Xor) instruction()                {}
+func (*int64Shl) instruction()                {}
+func (*int64ShrS) instruction()               {}
+func (*int64ShrU) instruction()               {}
+func (*int64Rotl) instruction()               {}
+func (*int64Rotr) instruction()               {}
+func (*float32Abs) instruction()              {}
+func (*float32Neg) instruction()              {}
+func (*float32Ceil) instruction()             {}
+func (*float32Floor) instruction()            {}
+func (*float32Trunc) instruction()            {}
+func (*float32Nearest) instruction()          {}
+func (*float32Sqrt) instruction()             {}
+func (*float32Add) instruction()              {}
+func (*float32Sub) instruction()              {}
+func (*float32Mul) instruction()              {}
+func (*float32Div) instruction()              {}
+func (*float32Min) instruction()              {}
+func (*float32Max) instruction()              {}
+func (*float32Copysign) instruction()         {}
+func (*float64Abs) instruction()              {}
+func (*float64Neg) instruction()              {}
+func (*float64Ceil) instruction()             {}
+func (*float64Floor) instruction()            {}
+func (*float64Trunc) instruction()            {}
+func (*float64Nearest) instruction()          {}
+func (*float64Sqrt) instruction()             {}
+func (*float64Add) instruction()              {}
+func (*float64Sub) instruction()              {}
+func (*float64Mul) instruction()              {}
+func (*float64Div) instruction()              {}
+func (*float64Min) instruction()              {}
+func (*float64Max) instruction()              {}
+func (*float64Copysign) instruction()         {}
+func (*int32WrapInt64) instruction()          {}
+func (*int32TruncFloat32S) instruction()      {}
+func (*int32TruncFloat32U) instruction()      {}
+func (*int32TruncFloat64S) instruction()      {}
+func (*int32TruncFloat64U) instruction()      {}
+func (*int64ExtendInt32S) instruction()       {}
+func (*int64ExtendInt32U) instruction()       {}
+func (*int64TruncFloat32S) instruction()      {}
+func (*int64TruncFloat32U) instruction()      {}
+func (*int64TruncFloat64S) instruction()      {}
+func (*int64TruncFloat64U) instruction()      {}
+func (*float32ConvertInt32S) instruction()    {}
+func (*float32ConvertInt32U) instruction()    {}
+func (*float32ConvertInt64S) instruction()    {}
+func (*float32ConvertInt64U) instruction()    {}
+func (*float32DemoteFloat64) instruction()    {}
+func (*float64ConvertInt32S) instruction()    {}
+func (*float64ConvertInt32U) instruction()    {}
+func (*float64ConvertInt64S) instruction()    {}
+func (*float64ConvertInt64U) instruction()    {}
+func (*float64PromoteFloat32) instruction()   {}
+func (*int32ReinterpretFloat32) instruction() {}
+func (*int64ReinterpretFloat64) instruction() {}
+func (*float32ReinterpretInt32) instruction() {}
+func (*float64ReinterpretInt64) instruction() {}
+func (*int32Extend8S) instruction()           {}
+func (*int32Extend16S) instruction()          {}
+func (*int64Extend8S) instruction()           {}
+func (*int64Extend16S) instruction()          {}
+func (*int64Extend32S) instruction()          {}
+func (*int32TruncSatFloat32S) instruction()   {}
+func (*int32TruncSatFloat32U) instruction()   {}
+func (*int32TruncSatFloat64S) instruction()   {}
+func (*int32TruncSatFloat64U) instruction()   {}
+func (*int64TruncSatFloat32S) instruction()   {}
+func (*int64TruncSatFloat32U) instruction()   {}
+func (*int64TruncSatFloat64S) instruction()   {}
+func (*int64TruncSatFloat64U) instruction()   {}
+
+func (*int32Eqz) opcode() opcode                { return 0x45 }
+func (*int32Eq) opcode() opcode                 { return 0x46 }
+func (*int32Ne) opcode() opcode                 { return 0x47 }
+func (*int32LtS) opcode() opcode                { return 0x48 }
+func (*int32LtU) opcode() opcode                { return 0x49 }
+func (*int32GtS) opcode() opcode                { return 0x4A }
+func (*int32GtU) opcode() opcode                { return 0x4B }
+func (*int32LeS) opcode() opcode                { return 0x4C }
+func (*int32LeU) opcode() opcode                { return 0x4D }
+func (*int32GeS) opcode() opcode                { return 0x4E }
+func (*int32GeU) opcode() opcode                { return 0x4F }
+func (*int64Eqz) opcode() opcode                { return 0x50 }
+func (*int64Eq) opcode() opcode                 { return 0x51 }
+func (*int64Ne) opcode() opcode                 { return 0x52 }
+func (*int64LtS) opcode() opcode                { return 0x53 }
+func (*int64LtU) opcode() opcode                { return 0x54 }
+func (*int64GtS) opcode() opcode                { return 0x55 }
+func (*int64GtU) opcode() opcode                { return 0x56 }
+func (*int64LeS) opcode() opcode                { return 0x57 }
+func (*int64LeU) opcode() opcode                { return 0x58 }
+func (*int64GeS) opcode() opcode                { return 0x59 }
+func (*int64GeU) opcode() opcode                { return 0x5A }
+func (*float32Eq) opcode() opcode               { return 0x5B }
+func (*float32Ne) opcode() opcode               { return 0x5C }
+func (*float32Lt) opcode() opcode               { return 0x5D }
+func (*float32Gt) opcode() opcode               { return 0x5E }
+func (*float32Le) opcode() opcode               { return 0x5F }
+func (*float32Ge) opcode() opcode               { return 0x60 }
+func (*float64Eq) opcode() opcode               { return 0x61 }
+func (*float64Ne) opcode() opcode               { return 0x62 }
+func (*float64Lt) opcode() opcode               { return 0x63 }
+func (*float64Gt) opcode() opcode               { return 0x64 }
+func (*float64Le) opcode() opcode               { return 0x65 }
+func (*float64Ge) opcode() opcode               { return 0x66 }
+func (*int32Clz) opcode() opcode                { return 0x67 }
+func (*int32Ctz) opcode() opcode                { return 0x68 }
+func (*int32Popcnt) opcode() opcode             { return 0x69 }
+func (*int32Add) opcode() opcode                { return 0x6A }
+func (*int32Sub) opcode() opcode                { return 0x6B }
+func (*int32Mul) opcode() opcode                { return 0x6C }
+func (*int32DivS) opcode() opcode               { return 0x6D }
+func (*int32DivU) opcode() opcode               { return 0x6E }
+func (*int32RemS) opcode() opcode               { return 0x6F }
+func (*int32RemU) opcode() opcode               { return 0x70 }
+func (*int32And) opcode() opcode                { return 0x71 }
+func (*int32Or) opcode() opcode                 { return 0x72 }
+func (*int32Xor) opcode() opcode                { return 0x73 }
+func (*int32Shl) opcode() opcode                { return 0x74 }
+func (*int32ShrS) opcode() opcode               { return 0x75 }
+func (*int32ShrU) opcode() opcode               { return 0x76 }
+func (*int32Rotl) opcode() opcode               { return 0x77 }
+func (*int32Rotr) opcode() opcode               { return 0x78 }
+func (*int64Clz) opcode() opcode                { return 0x79 }
+func (*int64Ctz) opcode() opcode                { return 0x7A }
+func (*int64Popcnt) opcode() opcode             { return 0x7B }
+func (*int64Add) opcode() opcode                { return 0x7C }
+func (*int64Sub) opcode() opcode                { return 0x7D }
+func (*int64Mul) opcode() opcode                { return 0x7E }
+func (*int64DivS) opcode() opcode               { return 0x7F }
+func (*int64DivU) opcode() opcode               { return 0x80 }
+func (*int64RemS) opcode() opcode               { return 0x81 }
+func (*int64RemU) opcode() opcode               { return 0x82 }
+func (*int64And) opcode() opcode                { return 0x83 }
+func (*int64Or) opcode() opcode                 { return 0x84 }
+func (*int64Xor) opcode() opcode                { return 0x85 }
+func (*int64Shl) opcode() opcode                { return 0x86 }
+func (*int64ShrS) opcode() opcode               { return 0x87 }
+func (*int64ShrU) opcode() opcode               { return 0x88 }
+func (*int64Rotl) opcode() opcode               { return 0x89 }
+func (*int64Rotr) opcode() opcode               { return 0x8A }
+func (*float32Abs) opcode() opcode              { return 0x8B }
+func (*float32Neg) opcode() opcode              { return 0x8C }
+func (*float32Ceil) opcode() opcode             { return 0x8D }
+func (*float32Floor) opcode() opcode            { return 0x8E }
+func (*float32Trunc) opcode() opcode            { return 0x8F }
+func (*float32Nearest) opcode() opcode          { return 0x90 }
+func (*float32Sqrt) opcode() opcode             { return 0x91 }
+func (*float32Add) opcode() opcode              { return 0x92 }
+func (*float32Sub) opcode() opcode              { return 0x93 }
+func (*float32Mul) opcode() opcode              { return 0x94 }
+func (*float32Div) opcode() opcode              { return 0x95 }
+func (*float32Min) opcode() opcode              { return 0x96 }
+func (*float32Max) opcode() opcode              { return 0x97 }
+func (*float32Copysign) opcode() opcode         { return 0x98 }
+func (*float64Abs) opcode() opcode              { return 0x99 }
+func (*float64Neg) opcode() opcode              { return 0x9A }
+func (*float64Ceil) opcode() opcode             { return 0x9B }
+func (*float64Floor) opcode() opcode            { return 0x9C }
+func (*float64Trunc) opcode() opcode            { return 0x9D }
+func (*float64Nearest) opcode() opcode          { return 0x9E }
+func (*float64Sqrt) opcode() opcode             { return 0x9F }
+func (*float64Add) opcode() opcode              { return 0xA0 }
+func (*float64Sub) opcode() opcode              { return 0xA1 }
+func (*float64Mul) opcode() opcode              { return 0xA2 }
+func (*float64Div) opcode() opcode              { return 0xA3 }
+func (*float64Min) opcode() opcode              { return 0xA4 }
+func (*float64Max) opcode() opcode              { return 0xA5 }
+func (*float64Copysign) opcode() opcode         { return 0xA6 }
+func (*int32WrapInt64) opcode() opcode          { return 0xA7 }
+func (*int32TruncFloat32S) opcode() opcode      { return 0xA8 }
+func (*int32TruncFloat32U) opcode() opcode      { return 0xA9 }
+func (*int32TruncFloat64S) opcode() opcode      { return 0xAA }
+func (*int32TruncFloat64U) opcode() opcode      { return 0xAB }
+func (*int64ExtendInt32S) opcode() opcode       { return 0xAC }
+func (*int64ExtendInt32U) opcode() opcode       { return 0xAD }
+func (*int64TruncFloat32S) opcode() opcode      { return 0xAE }
+func (*int64TruncFloat32U) opcode() opcode      { return 0xAF }
+func (*int64TruncFloat64S) opcode() opcode      { return 0xB0 }
+func (*int64TruncFloat64U) opcode() opcode      { return 0xB1 }
+func (*float32ConvertInt32S) opcode() opcode    { return 0xB2 }
+func (*float32ConvertInt32U) opcode() opcode    { return 0xB3 }
+func (*float32ConvertInt64S) opcode() opcode    { return 0xB4 }
+func (*float32ConvertInt64U) opcode() opcode    { return 0xB5 }
+func (*float32DemoteFloat64) opcode() opcode    { return 0xB6 }
+func (*float64ConvertInt32S) opcode() opcode    { return 0xB7 }
+func (*float64ConvertInt32U) opcode() opcode    { return 0xB8 }
+func (*float64ConvertInt64S) opcode() opcode    { return 0xB9 }
+func (*float64ConvertInt64U) opcode() opcode    { return 0xBA }
+func (*float64PromoteFloat32) opcode() opcode   { return 0xBB }
+func (*int32ReinterpretFloat32) opcode() opcode { return 0xBC }
+func (*int64ReinterpretFloat64) opcode() opcode { return 0xBD }
+func (*float32ReinterpretInt32) opcode() opcode { return 0xBE }
+func (*float64ReinterpretInt64) opcode() opcode { return 0xBF }
+func (*int32Extend8S) opcode() opcode           { return 0xC0 }
+func (*int32Extend16S) opcode() opcode          { return 0xC1 }
+func (*int64Extend8S) opcode() opcode           { return 0xC2 }
+func (*int64Extend16S) opcode() opcode          { return 0xC3 }
+func (*int64Extend32S) opcode() opcode          { return 0xC4 }
+func (*int32TruncSatFloat32S) opcode() opcode   { return 0xFC00 }
+func (*int32TruncSatFloat32U) opcode() opcode   { return 0xFC01 }
+func (*int32TruncSatFloat64S) opcode() opcode   { return 0xFC02 }
+func (*int32TruncSatFloat64U) opcode() opcode   { return 0xFC03 }
+func (*int64TruncSatFloat32S) opcode() opcode   { return 0xFC04 }
+func (*int64TruncSatFloat32U) opcode() opcode   { return 0xFC05 }
+func (*int64TruncSatFloat64S) opcode() opcode   { return 0xFC06 }
+func (*int64TruncSatFloat64U) opcode() opcode   { return 0xFC07 }
+
+// opcodeNames maps the opcode of every instruction to its name in the text format.
+// https://webassembly.github.io/spec/core/appendix/index-instructions.html
+var opcodeNames = map[opcode]string{
+	0x00:   "unreachable",
+	0x01:   "nop",
+	0x02:   "block",
+	0x03:   "loop",
+	0x04:   "if",
+	0x0C:   "br",
+	0x0D:   "br_if",
+	0x0E:   "br_table",
+	0x0F:   "return",
+	0x10:   "call",
+	0x11:   "call_indirect",
+	0xD0:   "ref.null",
+	0xD1:   "ref.is_null",
+	0xD2:   "ref.func",
+	0x1A:   "drop",
+	0x1B:   "select",
+	0x1C:   "select",
+	0x20:   "local.get",
+	0x21:   "local.set",
+	0x22:   "local.tee",
+	0x23:   "global.get",
+	0x24:   "global.set",
+	0x25:   "table.get",
+	0x26:   "table.set",
+	0xFC0C: "table.init",
+	0xFC0D: "elem.drop",
+	0xFC0E: "table.copy",
+	0xFC0F: "table.grow",
+	0xFC10: "table.size",
+	0xFC11: "table.fill",
+	0x28:   "i32.load",
+	0x29:   "i64.load",
+	0x2A:   "f32.load",
+	0x2B:   "f64.load",
+	0x2C:   "i32.load8_s",
+	0x2D:   "i32.load8_u",
+	0x2E:   "i32.load16_s",
+	0x2F:   "i32.load16_u",
+	0x30:   "i64.load8_s",
+	0x31:   "i64.load8_u",
+	0x32:   "i64.load16_s",
+	0x33:   "i64.load16_u",
+	0x34:   "i64.load32_s",
+	0x35:   "i64.load32_u",
+	0x36:   "i32.store",
+	0x37:   "i64.store",
+	0x38:   "f32.store",
+	0x39:   "f64.store",
+	0x3A:   "i32.store8",
+	0x3B:   "i32.store16",
+	0x3C:   "i64.store8",
+	0x3D:   "i64.store16",
+	0x3E:   "i64.store32",
+	0x3F:   "memory.size",
+	0x40:   "memory.grow",
+	0xFC08: "memory.init",
+	0xFC09: "data.drop",
+	0xFC0A: "memory.copy",
+	0xFC0B: "memory.fill",
+	0x41:   "i32.const",
+	0x42:   "i64.const",
+	0x43:   "f32.const",
+	0x44:   "f64.const",
+	0x45:   "i32.eqz",
+	0x46:   "i32.eq",
+	0x47:   "i32.ne",
+	0x48:   "i32.lt_s",
+	0x49:   "i32.lt_u",
+	0x4A:   "i32.gt_s",
+	0x4B:   "i32.gt_u",
+	0x4C:   "i32.le_s",
+	0x4D:   "i32.le_u",
+	0x4E:   "i32.ge_s",
+	0x4F:   "i32.ge_u",
+	0x50:   "i64.eqz",
+	0x51:   "i64.eq",
+	0x52:   "i64.ne",
+	0x53:   "i64.lt_s",
+	0x54:   "i64.lt_u",
+	0x55:   "i64.gt_s",
+	0x56:   "i64.gt_u",
+	0x57:   "i64.le_s",
+	0x58:   "i64.le_u",
+	0x59:   "i64.ge_s",
+	0x5A:   "i64.ge_u",
+	0x5B:   "f32.eq",
+	0x5C:   "f32.ne",
+	0x5D:   "f32.lt",
+	0x5E:   "f32.gt",
+	0x5F:   "f32.le",
+	0x60:   "f32.ge",
+	0x61:   "f64.eq",
+	0x62:   "f64.ne",
+	0x63:   "f64.lt",
+	0x64:   "f64.gt",
+	0x65:   "f64.le",
+	0x66:   "f64.ge",
+	0x67:   "i32.clz",
+	0x68:   "i32.ctz",
+	0x69:   "i32.popcnt",
+	0x6A:   "i32.add",
+	0x6B:   "i32.sub",
+	0x6C:   "i32.mul",
+	0x6D:   "i32.div_s",
+	0x6E:   "i32.div_u",
+	0x6F:   "i32.rem_s",
+	0x70:   "i32.rem_u",
+	0x71:   "i32.and",
+	0x72:   "i32.or",
+	0x73:   "i32.xor",
+	0x74:   "i32.shl",
+	0x75:   "i32.shr_s",
+	0x76:   "i32.shr_u",
+	0x77:   "i32.rotl",
+	0x78:   "i32.rotr",
+	0x79:   "i64.clz",
+	0x7A:   "i64.ctz",
+	0x7B:   "i64.popcnt",
+	0x7C:   "i64.add",
+	0x7D:   "i64.sub",
+	0x7E:   "i64.mul",
+	0x7F:   "i64.div_s",
+	0x80:   "i64.div_u",
+	0x81:   "i64.rem_s",
+	0x82:   "i64.rem_u",
+	0x83:   "i64.and",
+	0x84:   "i64.or",
+	0x85:   "i64.xor",
+	0x86:   "i64.shl",
+	0x87:   "i64.shr_s",
+	0x88:   "i64.shr_u",
+	0x89:   "i64.rotl",
+	0x8A:   "i64.rotr",
+	0x8B:   "f32.abs",
+	0x8C:   "f32.neg",
+	0x8D:   "f32.ceil",
+	0x8E:   "f32.floor",
+	0x8F:   "f32.trunc",
+	0x90:   "f32.nearest",
+	0x91:   "f32.sqrt",
+	0x92:   "f32.add",
+	0x93:   "f32.sub",
+	0x94:   "f32.mul",
+	0x95:   "f32.div",
+	0x96:   "f32.min",
+	0x97:   "f32.max",
+	0x98:   "f32.copysign",
+	0x99:   "f64.abs",
+	0x9A:   "f64.neg",
+	0x9B:   "f64.ceil",
+	0x9C:   "f64.floor",
+	0x9D:   "f64.trunc",
+	0x9E:   "f64.nearest",
+	0x9F:   "f64.sqrt",
+	0xA0:   "f64.add",
+	0xA1:   "f64.sub",
+	0xA2:   "f64.mul",
+	0xA3:   "f64.div",
+	0xA4:   "f64.min",
+	0xA5:   "f64.max",
+	0xA6:   "f64.copysign",
+	0xA7:   "i32.wrap_i64",
+	0xA8:   "i32.trunc_f32_s",
+	0xA9:   "i32.trunc_f32_u",
+	0xAA:   "i32.trunc_f64_s",
+	0xAB:   "i32.trunc_f64_u",
+	0xAC:   "i64.extend_i32_s",
+	0xAD:   "i64.extend_i32_u",
+	0xAE:   "i64.trunc_f32_s",
+	0xAF:   "i64.trunc_f32_u",
+	0xB0:   "i64.trunc_f64_s",
+	0xB1:   "i64.trunc_f64_u",
+	0xB2:   "f32.convert_i32_s",
+	0xB3:   "f32.convert_i32_u",
+	0xB4:   "f32.convert_i64_s",
+	0xB5:   "f32.convert_i64_u",
+	0xB6:   "f32.demote_f64",
+	0xB7:   "f64.convert_i32_s",
+	0xB8:   "f64.convert_i32_u",
+	0xB9:   "f64.convert_i64_s",
+	0xBA:   "f64.convert_i64_u",
+	0xBB:   "f64.promote_f32",
+	0xBC:   "i32.reinterpret_f32",
+	0xBD:   "i64.reinterpret_f64",
+	0xBE:   "f32.reinterpret_i32",
+	0xBF:   "f64.reinterpret_i64",
+	0xC0:   "i32.extend8_s",
+	0xC1:   "i32.extend16_s",
+	0xC2:   "i64.extend8_s",
+	0xC3:   "i64.extend16_s",
+	0xC4:   "i64.extend32_s",
+	0xFC00: "i32.trunc_sat_f32_s",
+	0xFC01: "i32.trunc_sat_f32_u",
+	0xFC02: "i32.trunc_sat_f64_s",
+	0xFC03: "i32.trunc_sat_f64_u",
+	0xFC04: "i64.trunc_sat_f32_s",
+	0xFC05: "i64.trunc_sat_f32_u",
+	0xFC06: "i64.trunc_sat_f64_s",
+	0xFC07: "i64.trunc_sat_f64_u",
+}
 
 func parseInstructions(r io.Reader) ([]instruction, error) {
+	// https://webassembly.github.io/spec/core/binary/instructions.html#expressions
+	//
+	// Expressions and the bodies of blocks and loops are encoded by their instruction
+	// sequence terminated with an explicit 0x0B opcode for end.
+
+	instructions, terminator, err := parseInstructionSequence(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if terminator != 0x0B {
+		return nil, fmt.Errorf("parsing instructions failed, unexpected else opcode: [%#X]", terminator)
+	}
+
+	return instructions, nil
+}
+
+func parseInstructionSequence(r io.Reader) ([]instruction, byte, error) {
 	// https://webassembly.github.io/spec/core/binary/instructions.html#instructions
 	//
 	// Instructions are encoded by opcodes. Each opcode is represented by a single
 	// byte, and is followed by the instruction’s immediate arguments, where present.
 	// The only exception are structured control instructions, which consist of several
 	// opcodes bracketing their nested instruction sequences.
+	//
+	// The sequence ends with either the end (0x0B) or the else (0x05) opcode, which
+	// is returned alongside the instructions.
+
+	// Structured instructions are parsed with an explicit stack instead of recursively,
+	// so that deeply nested input cannot exhaust the goroutine stack
+	type enclosing struct {
+		// structured is the *block, *loop or *ifInstruction whose instructions are parsed
+		structured instruction
+		// instructions is the sequence that structured is part of
+		instructions []instruction
+		// inElse is set once the else opcode of an if is read
+		inElse bool
+	}
 
+	var stack []enclosing
 	var instructions []instruction
 
 	for {
-		var opcode byte
-		err := binary.Read(r, binary.BigEndian, &opcode)
+		opcode, err := readByte(r)
 
 		if err != nil {
-			return nil, fmt.Errorf("reading instruction byte failed: %w", err)
+			return nil, 0, fmt.Errorf("reading instruction byte failed: %w", err)
 		}
 
-		// https://webassembly.github.io/spec/core/binary/instructions.html#expressions
-		if opcode == 0x0B {
-			break
-		}
+		switch opcode {
+		case 0x02, 0x03, 0x04:
+			if len(stack) >= maxNestingDepth {
+				return nil, 0, fmt.Errorf("parsing instructions failed, %w: more than [%d] levels", ErrNestingTooDeep, maxNestingDepth)
+			}
 
-		instruction, err := parseInstruction(r, opcode)
+			bt, err := parseBlockType(r)
+			if err != nil {
+				return nil, 0, fmt.Errorf("reading block type failed: %w", err)
+			}
 
-		if err != nil {
-			return nil, fmt.Errorf("parsing instructions failed, unknown opcode: [%#X]", opcode)
-		}
+			var structured instruction
+			switch opcode {
+			case 0x02:
+				structured = &block{bt: bt}
+			case 0x03:
+				structured = &loop{bt: bt}
+			default:
+				structured = &ifInstruction{bt: bt}
+			}
+
+			stack = append(stack, enclosing{structured, instructions, false})
+			instructions = nil
+		case 0x05:
+			if len(stack) == 0 {
+				return instructions, opcode, nil
+			}
 
-		instructions = append(instructions, instruction)
+			top := &stack[len(stack)-1]
+			i, ok := top.structured.(*ifInstruction)
+			if !ok || top.inElse {
+				return nil, 0, fmt.Errorf("parsing instructions failed, unexpected else opcode: [%#X]", opcode)
+			}
+
+			i.instructions = instructions
+			instructions = nil
+			top.inElse = true
+		case 0x0B:
+			if len(stack) == 0 {
+				return instructions, opcode, nil
+			}
+
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			switch structured := top.structured.(type) {
+			case *block:
+				structured.instructions = instructions
+			case *loop:
+				structured.instructions = instructions
+			case *ifInstruction:
+				if !top.inElse {
+					structured.instructions = instructions
+					break
+				}
+
+				structured.elseInstructions = instructions
+				if instructions == nil {
+					structured.elseInstructions = []instruction{}
+				}
+			}
+
+			instructions = append(top.instructions, top.structured)
+		default:
+			instruction, err := parseInstruction(r, opcode)
+
+			if err != nil {
+				return nil, 0, err
+			}
+
+			instructions = append(instructions, instruction)
+		}
 	}
+}
 
-	return instructions, nil
+func parseConstantExpression(r io.Reader) ([]instruction, error) {
+	// https://webassembly.github.io/spec/core/valid/instructions.html#constant-expressions
+	//
+	// Constant expressions are used as initializers for globals, element and data
+	// segment offsets. That they only contain constant instructions is checked by the
+	// validation, not when decoding.
+
+	return parseInstructions(r)
 }
 
 func parseInstruction(r io.Reader, opcode byte) (instruction, error) {
 
 	switch opcode {
+	// Control Instructions
+	case 0x00:
+		return &unreachable{}, nil
+	case 0x01:
+		return &nop{}, nil
+	case 0x0C:
+		return parseBr(r)
+	case 0x0D:
+		return parseBrIf(r)
+	case 0x0E:
+		return parseBrTable(r)
+	case 0x0F:
+		return &returnInstruction{}, nil
+	case 0x10:
+		return parseCall(r)
+	case 0x11:
+		return parseCallIndirect(r)
+	// Reference Instructions
+	case 0xD0:
+		return parseRefNull(r)
+	case 0xD1:
+		return &refIsNull{}, nil
+	case 0xD2:
+		return parseRefFunc(r)
+	// Parametric Instructions
+	case 0x1A:
+		return &drop{}, nil
+	case 0x1B:
+		return &selectInstruction{}, nil
+	case 0x1C:
+		return parseSelectTyped(r)
 	// Variable Instructions
 	case 0x20:
 		return parseLocalGet(r)
+	case 0x21:
+		return parseLocalSet(r)
+	case 0x22:
+		return parseLocalTee(r)
+	case 0x23:
+		return parseGlobalGet(r)
+	case 0x24:
+		return parseGlobalSet(r)
+	// Table Instructions
+	case 0x25:
+		return parseTableGet(r)
+	case 0x26:
+		return parseTableSet(r)
+	// Memory Instructions
+	case 0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F, 0x30, 0x31, 0x32, 0x33,
+		0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E:
+		return parseMemoryInstruction(r, opcode)
+	case 0x3F:
+		return &memorySize{}, parseReservedZeroByte(r)
+	case 0x40:
+		return &memoryGrow{}, parseReservedZeroByte(r)
 	// Numeric Instructions
+	case 0x41:
+		return parseInt32Const(r)
+	case 0x42:
+		return parseInt64Const(r)
+	case 0x43:
+		return parseFloat32Const(r)
+	case 0x44:
+		return parseFloat64Const(r)
+	case 0x45:
+		return &int32Eqz{}, nil
+	case 0x46:
+		return &int32Eq{}, nil
+	case 0x47:
+		return &int32Ne{}, nil
+	case 0x48:
+		return &int32LtS{}, nil
+	case 0x49:
+		return &int32LtU{}, nil
+	case 0x4A:
+		return &int32GtS{}, nil
+	case 0x4B:
+		return &int32GtU{}, nil
+	case 0x4C:
+		return &int32LeS{}, nil
+	case 0x4D:
+		return &int32LeU{}, nil
+	case 0x4E:
+		return &int32GeS{}, nil
+	case 0x4F:
+		return &int32GeU{}, nil
+	case 0x50:
+		return &int64Eqz{}, nil
+	case 0x51:
+		return &int64Eq{}, nil
+	case 0x52:
+		return &int64Ne{}, nil
+	case 0x53:
+		return &int64LtS{}, nil
+	case 0x54:
+		return &int64LtU{}, nil
+	case 0x55:
+		return &int64GtS{}, nil
+	case 0x56:
+		return &int64GtU{}, nil
+	case 0x57:
+		return &int64LeS{}, nil
+	case 0x58:
+		return &int64LeU{}, nil
+	case 0x59:
+		return &int64GeS{}, nil
+	case 0x5A:
+		return &int64GeU{}, nil
+	case 0x5B:
+		return &float32Eq{}, nil
+	case 0x5C:
+		return &float32Ne{}, nil
+	case 0x5D:
+		return &float32Lt{}, nil
+	case 0x5E:
+		return &float32Gt{}, nil
+	case 0x5F:
+		return &float32Le{}, nil
+	case 0x60:
+		return &float32Ge{}, nil
+	case 0x61:
+		return &float64Eq{}, nil
+	case 0x62:
+		return &float64Ne{}, nil
+	case 0x63:
+		return &float64Lt{}, nil
+	case 0x64:
+		return &float64Gt{}, nil
+	case 0x65:
+		return &float64Le{}, nil
+	case 0x66:
+		return &float64Ge{}, nil
+	case 0x67:
+		return &int32Clz{}, nil
+	case 0x68:
+		return &int32Ctz{}, nil
+	case 0x69:
+		return &int32Popcnt{}, nil
 	case 0x6A:
 		return &int32Add{}, nil
+	case 0x6B:
+		return &int32Sub{}, nil
+	case 0x6C:
+		return &int32Mul{}, nil
+	case 0x6D:
+		return &int32DivS{}, nil
+	case 0x6E:
+		return &int32DivU{}, nil
+	case 0x6F:
+		return &int32RemS{}, nil
+	case 0x70:
+		return &int32RemU{}, nil
+	case 0x71:
+		return &int32And{}, nil
+	case 0x72:
+		return &int32Or{}, nil
+	case 0x73:
+		return &int32Xor{}, nil
+	case 0x74:
+		return &int32Shl{}, nil
+	case 0x75:
+		return &int32ShrS{}, nil
+	case 0x76:
+		return &int32ShrU{}, nil
+	case 0x77:
+		return &int32Rotl{}, nil
+	case 0x78:
+		return &int32Rotr{}, nil
+	case 0x79:
+		return &int64Clz{}, nil
+	case 0x7A:
+		return &int64Ctz{}, nil
+	case 0x7B:
+		return &int64Popcnt{}, nil
+	case 0x7C:
+		return &int64Add{}, nil
+	case 0x7D:
+		return &int64Sub{}, nil
+	case 0x7E:
+		return &int64Mul{}, nil
+	case 0x7F:
+		return &int64DivS{}, nil
+	case 0x80:
+		return &int64DivU{}, nil
+	case 0x81:
+		return &int64RemS{}, nil
+	case 0x82:
+		return &int64RemU{}, nil
+	case 0x83:
+		return &int64And{}, nil
+	case 0x84:
+		return &int64Or{}, nil
+	case 0x85:
+		return &int64Xor{}, nil
+	case 0x86:
+		return &int64Shl{}, nil
+	case 0x87:
+		return &int64ShrS{}, nil
+	case 0x88:
+		return &int64ShrU{}, nil
+	case 0x89:
+		return &int64Rotl{}, nil
+	case 0x8A:
+		return &int64Rotr{}, nil
+	case 0x8B:
+		return &float32Abs{}, nil
+	case 0x8C:
+		return &float32Neg{}, nil
+	case 0x8D:
+		return &float32Ceil{}, nil
+	case 0x8E:
+		return &float32Floor{}, nil
+	case 0x8F:
+		return &float32Trunc{}, nil
+	case 0x90:
+		return &float32Nearest{}, nil
+	case 0x91:
+		return &float32Sqrt{}, nil
+	case 0x92:
+		return &float32Add{}, nil
+	case 0x93:
+		return &float32Sub{}, nil
+	case 0x94:
+		return &float32Mul{}, nil
+	case 0x95:
+		return &float32Div{}, nil
+	case 0x96:
+		return &float32Min{}, nil
+	case 0x97:
+		return &float32Max{}, nil
+	case 0x98:
+		return &float32Copysign{}, nil
+	case 0x99:
+		return &float64Abs{}, nil
+	case 0x9A:
+		return &float64Neg{}, nil
+	case 0x9B:
+		return &float64Ceil{}, nil
+	case 0x9C:
+		return &float64Floor{}, nil
+	case 0x9D:
+		return &float64Trunc{}, nil
+	case 0x9E:
+		return &float64Nearest{}, nil
+	case 0x9F:
+		return &float64Sqrt{}, nil
+	case 0xA0:
+		return &float64Add{}, nil
+	case 0xA1:
+		return &float64Sub{}, nil
+	case 0xA2:
+		return &float64Mul{}, nil
+	case 0xA3:
+		return &float64Div{}, nil
+	case 0xA4:
+		return &float64Min{}, nil
+	case 0xA5:
+		return &float64Max{}, nil
+	case 0xA6:
+		return &float64Copysign{}, nil
+	case 0xA7:
+		return &int32WrapInt64{}, nil
+	case 0xA8:
+		return &int32TruncFloat32S{}, nil
+	case 0xA9:
+		return &int32TruncFloat32U{}, nil
+	case 0xAA:
+		return &int32TruncFloat64S{}, nil
+	case 0xAB:
+		return &int32TruncFloat64U{}, nil
+	case 0xAC:
+		return &int64ExtendInt32S{}, nil
+	case 0xAD:
+		return &int64ExtendInt32U{}, nil
+	case 0xAE:
+		return &int64TruncFloat32S{}, nil
+	case 0xAF:
+		return &int64TruncFloat32U{}, nil
+	case 0xB0:
+		return &int64TruncFloat64S{}, nil
+	case 0xB1:
+		return &int64TruncFloat64U{}, nil
+	case 0xB2:
+		return &float32ConvertInt32S{}, nil
+	case 0xB3:
+		return &float32ConvertInt32U{}, nil
+	case 0xB4:
+		return &float32ConvertInt64S{}, nil
+	case 0xB5:
+		return &float32ConvertInt64U{}, nil
+	case 0xB6:
+		return &float32DemoteFloat64{}, nil
+	case 0xB7:
+		return &float64ConvertInt32S{}, nil
+	case 0xB8:
+		return &float64ConvertInt32U{}, nil
+	case 0xB9:
+		return &float64ConvertInt64S{}, nil
+	case 0xBA:
+		return &float64ConvertInt64U{}, nil
+	case 0xBB:
+		return &float64PromoteFloat32{}, nil
+	case 0xBC:
+		return &int32ReinterpretFloat32{}, nil
+	case 0xBD:
+		return &int64ReinterpretFloat64{}, nil
+	case 0xBE:
+		return &float32ReinterpretInt32{}, nil
+	case 0xBF:
+		return &float64ReinterpretInt64{}, nil
+	case 0xC0:
+		return &int32Extend8S{}, nil
+	case 0xC1:
+		return &int32Extend16S{}, nil
+	case 0xC2:
+		return &int64Extend8S{}, nil
+	case 0xC3:
+		return &int64Extend16S{}, nil
+	case 0xC4:
+		return &int64Extend32S{}, nil
+	case 0xFC:
+		return parsePrefixedInstruction(r)
+	default:
+		return nil, fmt.Errorf("parsing instructions failed, %w: [%#X]", ErrUnknownOpcode, opcode)
+	}
+}
+
+// newInstruction creates an instruction that has no immediates in the text format. The
+// reserved memory index bytes of the binary format are supplied as zeros.
+func newInstruction(op opcode) (instruction, error) {
+	reserved := bytes.NewReader([]byte{0x00, 0x00})
+	if op > 0xFF {
+		subOpcode := bytes.NewReader(appendUnsigned(nil, uint64(op&0xFF)))
+		return parsePrefixedInstruction(io.MultiReader(subOpcode, reserved))
+	}
+	return parseInstruction(reserved, byte(op))
+}
+
+func parsePrefixedInstruction(r io.Reader) (instruction, error) {
+	// Saturating truncation, bulk memory and table instructions share the prefix byte 0xFC
+	// which is followed by a u32 sub-opcode.
+
+	subOpcode, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading sub-opcode of prefixed instruction failed: %w", err)
+	}
+
+	switch subOpcode {
+	// Numeric Instructions
+	case 0:
+		return &int32TruncSatFloat32S{}, nil
+	case 1:
+		return &int32TruncSatFloat32U{}, nil
+	case 2:
+		return &int32TruncSatFloat64S{}, nil
+	case 3:
+		return &int32TruncSatFloat64U{}, nil
+	case 4:
+		return &int64TruncSatFloat32S{}, nil
+	case 5:
+		return &int64TruncSatFloat32U{}, nil
+	case 6:
+		return &int64TruncSatFloat64S{}, nil
+	case 7:
+		return &int64TruncSatFloat64U{}, nil
+	// Memory Instructions
+	case 8:
+		return parseMemoryInit(r)
+	case 9:
+		return parseDataDrop(r)
+	case 10:
+		err := parseReservedZeroByte(r)
+		if err != nil {
+			return nil, err
+		}
+		return &memoryCopy{}, parseReservedZeroByte(r)
+	case 11:
+		return &memoryFill{}, parseReservedZeroByte(r)
+	// Table Instructions
+	case 12:
+		return parseTableInit(r)
+	case 13:
+		return parseElemDrop(r)
+	case 14:
+		return parseTableCopy(r)
+	case 15:
+		return parseTableGrow(r)
+	case 16:
+		return parseTableSize(r)
+	case 17:
+		return parseTableFill(r)
+	default:
+		return nil, fmt.Errorf("parsing instructions failed, %w: [0xFC %d]", ErrUnknownOpcode, subOpcode)
+	}
+}
+
+// naturalAlignment returns the alignment exponent of a memory instruction that matches
+// the width of the accessed memory.
+func naturalAlignment(op opcode) uint32 {
+	switch op {
+	case 0x2C, 0x2D, 0x30, 0x31, 0x3A, 0x3C:
+		return 0
+	case 0x2E, 0x2F, 0x32, 0x33, 0x3B, 0x3D:
+		return 1
+	case 0x28, 0x2A, 0x34, 0x35, 0x36, 0x38, 0x3E:
+		return 2
 	default:
-		return nil, fmt.Errorf("parsing instructions failed, unknown opcode: [%#X]", opcode)
+		return 3
 	}
 }
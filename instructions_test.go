@@ -0,0 +1,150 @@
+package jwasm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingInstructions(t *testing.T) {
+	data := []byte{
+		0x20, 0x00, // local.get 0
+		0x41, 0x7F, // i32.const -1
+		0x28, 0x02, 0x10, // i32.load align=2 offset=16
+		0x22, 0x01, // local.tee 1
+		0x11, 0x03, 0x00, // call_indirect (type 3) table 0
+		0x1C, 0x01, 0x7E, // select (result i64)
+		0x3F, 0x00, // memory.size
+		0xFC, 0x0E, 0x01, 0x02, // table.copy 1 2
+		0xFC, 0x07, // i64.trunc_sat_f64_u
+		0x0B,
+	}
+
+	instructions, err := parseInstructions(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, instructions, 9)
+	assert.Equal(t, &localGet{0}, instructions[0])
+	assert.Equal(t, &int32Const{-1}, instructions[1])
+	assert.Equal(t, &int32Load{memoryArgument{2, 16}}, instructions[2])
+	assert.Equal(t, &localTee{1}, instructions[3])
+	assert.Equal(t, &callIndirect{3, 0}, instructions[4])
+	assert.Len(t, instructions[5].(*selectTyped).t, 1)
+	assert.Equal(t, &memorySize{}, instructions[6])
+	assert.Equal(t, &tableCopy{1, 2}, instructions[7])
+	assert.Equal(t, &int64TruncSatFloat64U{}, instructions[8])
+}
+
+func TestParsingInstructionsCoversOpcodeTable(t *testing.T) {
+	for op, name := range opcodeNames {
+		t.Run(name, func(t *testing.T) {
+			var data []byte
+			if op > 0xFF {
+				data = []byte{byte(op >> 8), byte(op)}
+			} else {
+				data = []byte{byte(op)}
+			}
+
+			// Zero bytes are valid immediates for every instruction but ref.null and
+			// structured instructions, which need an end opcode for themselves and for the
+			// sequence they are decoded in
+			switch op {
+			case 0xD0:
+				data = append(data, 0x70)
+			case 0x02, 0x03, 0x04:
+				data = append(data, 0x40, 0x0B, 0x0B)
+			}
+			data = append(data, make([]byte, 8)...)
+
+			var decoded instruction
+			var err error
+			r := bytes.NewReader(data)
+			switch op {
+			case 0x02, 0x03, 0x04:
+				var instructions []instruction
+				instructions, _, err = parseInstructionSequence(r)
+				if err == nil {
+					decoded = instructions[0]
+				}
+			default:
+				opcodeByte, _ := r.ReadByte()
+				decoded, err = parseInstruction(r, opcodeByte)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, op, decoded.opcode())
+		})
+	}
+}
+
+func TestParsingInstructionsFailsOnUnknownOpcode(t *testing.T) {
+	_, err := parseInstructions(bytes.NewReader([]byte{0xFF, 0x0B}))
+
+	assert.ErrorContains(t, err, "unknown opcode")
+}
+
+func TestParsingStructuredInstructions(t *testing.T) {
+	data := []byte{
+		0x02, 0x40, // block
+		0x03, 0x7F, // loop (result i32)
+		0x20, 0x00, // local.get 0
+		0x0D, 0x00, // br_if 0
+		0x0C, 0x01, // br 1
+		0x0B,       // end
+		0x04, 0x05, // if (type 5)
+		0x0E, 0x02, 0x00, 0x01, 0x02, // br_table 0 1 2
+		0x05,                   // else
+		0x01,                   // nop
+		0x0B,                   // end
+		0x04, 0x40, 0x05, 0x0B, // if else end
+		0x0B, // end
+		0x0B,
+	}
+
+	instructions, err := parseInstructions(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []instruction{
+		&block{&blockTypeEmpty{}, []instruction{
+			&loop{&blockTypeValue{ValueTypeI32}, []instruction{
+				&localGet{0},
+				&brIf{0},
+				&br{1},
+			}},
+			&ifInstruction{&blockTypeIndex{5}, []instruction{
+				&brTable{[]labelIndex{0, 1}, 2},
+			}, []instruction{
+				&nop{},
+			}},
+			&ifInstruction{&blockTypeEmpty{}, nil, []instruction{}},
+		}},
+	}, instructions)
+}
+
+func TestParsingInstructionsFailsOnMisplacedElse(t *testing.T) {
+	_, err := parseInstructions(bytes.NewReader([]byte{0x02, 0x40, 0x05, 0x0B, 0x0B}))
+
+	assert.ErrorContains(t, err, "unexpected else")
+}
+
+func TestParsingInstructionsLimitsNesting(t *testing.T) {
+	nested := func(depth int) []byte {
+		data := bytes.Repeat([]byte{0x02, 0x40}, depth)
+		return append(data, bytes.Repeat([]byte{0x0B}, depth+1)...)
+	}
+
+	instructions, err := parseInstructions(bytes.NewReader(nested(maxNestingDepth)))
+	if assert.NoError(t, err) {
+		assert.Len(t, instructions, 1)
+	}
+
+	_, err = parseInstructions(bytes.NewReader(nested(5000000)))
+	assert.ErrorIs(t, err, ErrNestingTooDeep)
+}
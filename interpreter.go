@@ -1,7 +1,427 @@
 package jwasm
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// maxDepth limits the number of nested calls and structured instructions before execution
+// traps with ErrCallStackExhausted. Both share the limit since both nest on the goroutine
+// stack, which must not overflow.
+const maxDepth = 10000
+
+// Interpreter instantiates modules and executes their functions by walking their
+// instructions.
+// https://webassembly.github.io/spec/core/exec/index.html
 type Interpreter struct {
 }
 
+// VM executes the functions of a store, it holds the stack of all active calls.
+// https://webassembly.github.io/spec/core/exec/runtime.html#stack
 type VM struct {
+	store *Store
+	// instance is the module instance of the active frame
+	instance *Instance
+
+	// ctx is the context of the active call, which is passed to host functions
+	ctx context.Context
+	// stack holds the operands and locals of all active calls as raw bits, see numeric.go
+	stack []uint64
+	// depth is the number of active calls and structured instructions, see maxDepth
+	depth int
+
+	// metered is set if instructions consume fuel, see fuel.go
+	metered bool
+	fuel    uint64
+	costs   map[opcode]uint64
+}
+
+// frame is the activation of a function call.
+// https://webassembly.github.io/spec/core/exec/runtime.html#activations-and-frames
+type frame struct {
+	function *function
+	// locals is the position on the stack of the first parameter
+	locals int
+}
+
+// Outcomes of executing a sequence of instructions besides branches, which are reported
+// as the relative depth of their label.
+const (
+	completed = -1
+	returned  = -2
+)
+
+// Instantiate validates m and instantiates it in a new store, see Linker.Instantiate.
+// Modules with imports have to be instantiated by a Linker.
+// https://webassembly.github.io/spec/core/exec/modules.html#instantiation
+func (i *Interpreter) Instantiate(m *Module) (*Instance, error) {
+	return (&Linker{}).Instantiate(context.Background(), NewStore(), m)
+}
+
+// evaluate returns the value of a constant expression, which consumes no fuel.
+// https://webassembly.github.io/spec/core/exec/instructions.html#expressions
+func (vm *VM) evaluate(expression []instruction) (uint64, error) {
+	metered := vm.metered
+	vm.metered = false
+	_, err := vm.execute(expression, nil)
+	vm.metered = metered
+
+	if err != nil {
+		return 0, err
+	}
+	return vm.pop(), nil
+}
+
+// invoke calls f with the arguments on top of the stack, which are replaced by its results.
+// The stack is reset to its height before the arguments if the call traps. Panics are
+// recovered as traps, so that they never reach the caller.
+func (vm *VM) invoke(f *function) (err error) {
+	height := len(vm.stack) - len(f.functionType.ParameterTypes)
+	instance, depth := vm.instance, vm.depth
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = &Trap{Code: TrapPanic, Err: &panicError{r}}
+		}
+		if err != nil {
+			vm.stack = vm.stack[:height]
+			vm.instance, vm.depth = instance, depth
+		}
+	}()
+
+	return newTrap(vm.call(f))
+}
+
+// Stack
+
+func (vm *VM) push(value uint64) {
+	vm.stack = append(vm.stack, value)
+}
+
+func (vm *VM) pop() uint64 {
+	value := vm.stack[len(vm.stack)-1]
+	vm.stack = vm.stack[:len(vm.stack)-1]
+	return value
+}
+
+// unwind removes the operands above height except for the arity values on top.
+func (vm *VM) unwind(height int, arity int) {
+	copy(vm.stack[height:], vm.stack[len(vm.stack)-arity:])
+	vm.stack = vm.stack[:height+arity]
+}
+
+// Execution
+// https://webassembly.github.io/spec/core/exec/instructions.html
+
+// call executes the body of f with the arguments on top of the stack. Host functions run
+// in the module instance of their caller.
+func (vm *VM) call(f *function) error {
+	if vm.depth >= maxDepth {
+		return ErrCallStackExhausted
+	}
+	vm.depth++
+
+	if f.host != nil {
+		if err := vm.callHost(f.host); err != nil {
+			return err
+		}
+		vm.depth--
+		return nil
+	}
+
+	caller := vm.instance
+	vm.instance = f.instance
+
+	fr := frame{f, len(vm.stack) - len(f.functionType.ParameterTypes)}
+	vm.stack = append(vm.stack, make([]uint64, f.locals)...)
+
+	// Branches to the outermost label and returns both end the function
+	if _, err := vm.execute(f.code.body, &fr); err != nil {
+		err = newTrap(err)
+		if t, ok := err.(*Trap); ok {
+			t.Backtrace = append(t.Backtrace, f.trapFrame())
+		}
+		return err
+	}
+
+	vm.unwind(fr.locals, len(f.functionType.ResultTypes))
+	vm.instance = caller
+	vm.depth--
+	return nil
+}
+
+// arity returns the number of parameters and results of a block type.
+func (vm *VM) arity(bt blockType) (int, int) {
+	switch bt := bt.(type) {
+	case *blockTypeValue:
+		return 0, 1
+	case *blockTypeIndex:
+		functionType := vm.instance.module.TypeSection.FunctionTypes[bt.x]
+		return len(functionType.ParameterTypes), len(functionType.ResultTypes)
+	default:
+		return 0, 0
+	}
+}
+
+// structured executes the instructions of a block or loop, the arity of its label is the
+// number of values that a branch to it keeps. It returns the outcome for the instructions
+// that surround it.
+func (vm *VM) structured(instructions []instruction, fr *frame, height int, arity int, loop bool) (int, error) {
+	if vm.depth >= maxDepth {
+		return 0, ErrCallStackExhausted
+	}
+	vm.depth++
+
+	for {
+		l, err := vm.execute(instructions, fr)
+		switch {
+		case err != nil:
+			return l, err
+		case l == completed || l == returned:
+			vm.depth--
+			return l, nil
+		case l > 0:
+			vm.depth--
+			return l - 1, nil
+		}
+
+		vm.unwind(height, arity)
+		if !loop {
+			vm.depth--
+			return completed, nil
+		}
+	}
+}
+
+// execute runs instructions until they complete, branch to a label that surrounds them
+// or return. fr is nil for constant expressions.
+func (vm *VM) execute(instructions []instruction, fr *frame) (int, error) {
+	for _, instruction := range instructions {
+		if vm.metered {
+			if err := vm.consume(instruction.opcode()); err != nil {
+				return 0, err
+			}
+		}
+
+		switch instruction := instruction.(type) {
+		// Control Instructions
+		case *unreachable:
+			return 0, ErrUnreachable
+		case *nop:
+		case *block:
+			parameters, results := vm.arity(instruction.bt)
+			l, err := vm.structured(instruction.instructions, fr, len(vm.stack)-parameters, results, false)
+			if err != nil || l != completed {
+				return l, err
+			}
+		case *loop:
+			parameters, _ := vm.arity(instruction.bt)
+			l, err := vm.structured(instruction.instructions, fr, len(vm.stack)-parameters, parameters, true)
+			if err != nil || l != completed {
+				return l, err
+			}
+		case *ifInstruction:
+			instructions := instruction.instructions
+			if vm.pop() == 0 {
+				instructions = instruction.elseInstructions
+			}
+
+			parameters, results := vm.arity(instruction.bt)
+			l, err := vm.structured(instructions, fr, len(vm.stack)-parameters, results, false)
+			if err != nil || l != completed {
+				return l, err
+			}
+		case *br:
+			return int(instruction.l), nil
+		case *brIf:
+			if vm.pop() != 0 {
+				return int(instruction.l), nil
+			}
+		case *brTable:
+			i := uint32(vm.pop())
+			if int64(i) < int64(len(instruction.l)) {
+				return int(instruction.l[i]), nil
+			}
+			return int(instruction.lN), nil
+		case *returnInstruction:
+			return returned, nil
+		case *call:
+			if err := vm.call(vm.instance.functions[instruction.x]); err != nil {
+				return 0, err
+			}
+		case *callIndirect:
+			if err := vm.callIndirect(instruction.x, instruction.y); err != nil {
+				return 0, err
+			}
+
+		// Reference Instructions
+		case *refNull:
+			vm.push(0)
+		case *refIsNull:
+			vm.stack[len(vm.stack)-1] = boolValue(vm.stack[len(vm.stack)-1] == 0)
+		case *refFunc:
+			vm.push(uint64(vm.instance.functions[instruction.x].addr) + 1)
+
+		// Parametric Instructions
+		case *drop:
+			vm.pop()
+		case *selectInstruction, *selectTyped:
+			c := vm.pop()
+			b := vm.pop()
+			if c == 0 {
+				vm.stack[len(vm.stack)-1] = b
+			}
+
+		// Variable Instructions
+		case *localGet:
+			vm.push(vm.stack[fr.locals+int(instruction.x)])
+		case *localSet:
+			vm.stack[fr.locals+int(instruction.x)] = vm.pop()
+		case *localTee:
+			vm.stack[fr.locals+int(instruction.x)] = vm.stack[len(vm.stack)-1]
+		case *globalGet:
+			vm.push(vm.instance.globals[instruction.x].value)
+		case *globalSet:
+			vm.instance.globals[instruction.x].value = vm.pop()
+
+		// Table Instructions
+		case *tableGet:
+			if err := vm.tableGet(instruction.x); err != nil {
+				return 0, err
+			}
+		case *tableSet:
+			if err := vm.tableSet(instruction.x); err != nil {
+				return 0, err
+			}
+		case *tableSize:
+			vm.push(uint64(len(vm.instance.tables[instruction.x].elements)))
+		case *tableGrow:
+			vm.tableGrow(instruction.x)
+		case *tableFill:
+			if err := vm.tableFill(instruction.x); err != nil {
+				return 0, err
+			}
+		case *tableCopy:
+			if err := vm.tableCopy(instruction.x, instruction.y); err != nil {
+				return 0, err
+			}
+		case *tableInit:
+			if err := vm.tableInit(instruction.x, instruction.y); err != nil {
+				return 0, err
+			}
+		case *elemDrop:
+			vm.instance.elements[instruction.x] = nil
+
+		// Memory Instructions
+		case memoryAccess:
+			if err := vm.access(instruction.opcode(), instruction.memoryArgument()); err != nil {
+				return 0, err
+			}
+		case *memorySize:
+			vm.push(uint64(vm.instance.memories[0].pages()))
+		case *memoryGrow:
+			vm.memoryGrow()
+		case *memoryFill:
+			if err := vm.memoryFill(); err != nil {
+				return 0, err
+			}
+		case *memoryCopy:
+			if err := vm.memoryCopy(); err != nil {
+				return 0, err
+			}
+		case *memoryInit:
+			if err := vm.memoryInit(instruction.x); err != nil {
+				return 0, err
+			}
+		case *dataDrop:
+			vm.instance.datas[instruction.x] = nil
+
+		// Numeric Instructions
+		case *int32Const:
+			vm.push(uint64(uint32(instruction.n)))
+		case *int64Const:
+			vm.push(uint64(instruction.n))
+		case *float32Const:
+			vm.push(uint64(math.Float32bits(instruction.z)))
+		case *float64Const:
+			vm.push(math.Float64bits(instruction.z))
+		default:
+			if err := vm.numeric(instruction.opcode()); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return completed, nil
+}
+
+// numeric executes the numeric instruction op on the operands on top of the stack.
+func (vm *VM) numeric(op opcode) error {
+	top := len(vm.stack) - 1
+
+	switch {
+	case op == 0x45:
+		vm.stack[top] = boolValue(int32Compare(op, uint32(vm.stack[top]), 0))
+	case op == 0x50:
+		vm.stack[top] = boolValue(int64Compare(op, vm.stack[top], 0))
+	case op >= 0x46 && op <= 0x4F:
+		b := vm.pop()
+		vm.stack[top-1] = boolValue(int32Compare(op, uint32(vm.stack[top-1]), uint32(b)))
+	case op >= 0x51 && op <= 0x5A:
+		b := vm.pop()
+		vm.stack[top-1] = boolValue(int64Compare(op, vm.stack[top-1], b))
+	case op >= 0x5B && op <= 0x60:
+		b := float64(math.Float32frombits(uint32(vm.pop())))
+		vm.stack[top-1] = boolValue(floatCompare(op, float64(math.Float32frombits(uint32(vm.stack[top-1]))), b))
+	case op >= 0x61 && op <= 0x66:
+		b := math.Float64frombits(vm.pop())
+		vm.stack[top-1] = boolValue(floatCompare(op, math.Float64frombits(vm.stack[top-1]), b))
+	case op >= 0x67 && op <= 0x69, op == 0xC0, op == 0xC1:
+		vm.stack[top] = uint64(int32Unary(op, uint32(vm.stack[top])))
+	case op >= 0x6A && op <= 0x78:
+		b := vm.pop()
+		c, err := int32Binary(op, uint32(vm.stack[top-1]), uint32(b))
+		if err != nil {
+			return err
+		}
+		vm.stack[top-1] = uint64(c)
+	case op >= 0x79 && op <= 0x7B, op >= 0xC2 && op <= 0xC4:
+		vm.stack[top] = int64Unary(op, vm.stack[top])
+	case op >= 0x7C && op <= 0x8A:
+		b := vm.pop()
+		c, err := int64Binary(op, vm.stack[top-1], b)
+		if err != nil {
+			return err
+		}
+		vm.stack[top-1] = c
+	case op >= 0x8B && op <= 0x91:
+		vm.stack[top] = uint64(float32Unary(op, uint32(vm.stack[top])))
+	case op >= 0x92 && op <= 0x98:
+		b := vm.pop()
+		vm.stack[top-1] = uint64(float32Binary(op, uint32(vm.stack[top-1]), uint32(b)))
+	case op >= 0x99 && op <= 0x9F:
+		vm.stack[top] = float64Unary(op, vm.stack[top])
+	case op >= 0xA0 && op <= 0xA6:
+		b := vm.pop()
+		vm.stack[top-1] = float64Binary(op, vm.stack[top-1], b)
+	case op >= 0xA7 && op <= 0xBF, op >= 0xFC00 && op <= 0xFC07:
+		c, err := convert(op, vm.stack[top])
+		if err != nil {
+			return err
+		}
+		vm.stack[top] = c
+	default:
+		return fmt.Errorf("executing %s failed: %w", opcodeNames[op], errors.ErrUnsupported)
+	}
+
+	return nil
+}
+
+func boolValue(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
 }
@@ -0,0 +1,163 @@
+package jwasm
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func instantiate(t *testing.T, text string) *Instance {
+	t.Helper()
+
+	inst, err := (&Interpreter{}).Instantiate(assemble(t, text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return inst
+}
+
+func TestCallingExportedFunctions(t *testing.T) {
+	inst := instantiate(t, `(module
+  (func (export "mix") (param i32 i64 f32 f64) (result f64 f32 i64 i32)
+    local.get 3 local.get 2 local.get 1 local.get 0)
+  (func (export "id") (param externref) (result externref) (local.get 0))
+  (func $f (export "ref") (result funcref) (ref.func $f))
+  (global (export "pi") f64 (f64.const 3.14)))`)
+
+	results, err := inst.Call("mix", int32(-1), int64(2), float32(1.5), math.Inf(-1))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{math.Inf(-1), float32(1.5), int64(2), int32(-1)}, results)
+	}
+
+	host := &struct{ name string }{"host"}
+	results, err = inst.Call("id", host)
+	if assert.NoError(t, err) {
+		assert.Same(t, host, results[0])
+	}
+
+	results, err = inst.Call("id", nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{nil}, results)
+	}
+
+	results, err = inst.Call("ref")
+	if assert.NoError(t, err) {
+		assert.NotNil(t, results[0])
+	}
+
+	pi, err := inst.Global("pi")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 3.14, pi)
+	}
+}
+
+func TestCallingRejectsInvalidArguments(t *testing.T) {
+	inst := instantiate(t, `(func (export "f") (param i32))`)
+
+	_, err := inst.Call("f", int64(1))
+	assert.ErrorIs(t, err, ErrTypeMismatch)
+
+	_, err = inst.Call("f")
+	assert.Error(t, err)
+
+	_, err = inst.Call("g")
+	assert.Error(t, err)
+}
+
+func TestCallingRecoversFromTraps(t *testing.T) {
+	inst := instantiate(t, `(func (export "div") (param i32 i32) (result i32)
+  (i32.div_u (local.get 0) (local.get 1)))`)
+
+	_, err := inst.Call("div", int32(1), int32(0))
+	assert.ErrorIs(t, err, ErrIntegerDivideByZero)
+	assert.Empty(t, inst.store.vm.stack)
+
+	results, err := inst.Call("div", int32(6), int32(3))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{int32(2)}, results)
+	}
+}
+
+func TestTrapsHaveBacktraces(t *testing.T) {
+	module := assemble(t, `(module
+  (func $add (param i32 i32) (result i32) (i32.div_s (local.get 0) (local.get 1)))
+  (func (export "div") (param i32) (result i32) (call $add (i32.const 1) (local.get 0)))
+  (func $loop (export "loop") (call $loop)))`)
+	module.addSection(&CustomSection{"name", nameSectionTestData})
+
+	inst, err := (&Interpreter{}).Instantiate(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = inst.Call("div", int32(0))
+	var trap *Trap
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapIntegerDivideByZero, trap.Code)
+		assert.Equal(t, []TrapFrame{{"m", 0, "add"}, {"m", 1, ""}}, trap.Backtrace)
+		assert.ErrorIs(t, err, ErrIntegerDivideByZero)
+		assert.ErrorContains(t, err, `integer divide by zero in function [0] "add" of module "m"`)
+	}
+
+	_, err = inst.Call("loop")
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapCallStackExhausted, trap.Code)
+		assert.Len(t, trap.Backtrace, maxDepth)
+	}
+}
+
+func TestCallingNestedBlocksRecursivelyTraps(t *testing.T) {
+	// Calls and blocks share one depth limit, otherwise the goroutine stack would overflow
+	nesting := 2000
+	inst := instantiate(t, `(module (func $f (export "f") `+
+		strings.Repeat("(block ", nesting)+"(call $f)"+strings.Repeat(")", nesting)+"))")
+
+	_, err := inst.Call("f")
+
+	var trap *Trap
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapCallStackExhausted, trap.Code)
+		assert.Len(t, trap.Backtrace, maxDepth/(nesting+1)+1)
+	}
+}
+
+func TestTrapCodes(t *testing.T) {
+	assert.Equal(t, "out of bounds memory access", TrapOutOfBoundsMemoryAccess.String())
+	assert.Equal(t, "host function", TrapHostFunction.String())
+	assert.Equal(t, "TrapCode(42)", TrapCode(42).String())
+}
+
+func TestCallingReleasesExternalReferences(t *testing.T) {
+	inst := instantiate(t, `(module
+  (global $g (mut externref) (ref.null extern))
+  (table $t 1 externref)
+  (func (export "id") (param externref) (result externref) (local.get 0))
+  (func (export "keep") (param externref externref)
+    (global.set $g (local.get 0))
+    (table.set $t (i32.const 0) (local.get 1)))
+  (func (export "kept") (result externref externref)
+    (global.get $g) (table.get $t (i32.const 0))))`)
+
+	global, table := &struct{ name string }{"global"}, &struct{ name string }{"table"}
+	if _, err := inst.Call("keep", global, table); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10000; i++ {
+		v := &struct{ i int }{i}
+		results, err := inst.Call("id", v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Same(t, v, results[0])
+	}
+	assert.Less(t, len(inst.store.externs), 4096)
+
+	results, err := inst.Call("kept")
+	if assert.NoError(t, err) {
+		assert.Same(t, global, results[0])
+		assert.Same(t, table, results[1])
+	}
+}
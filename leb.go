@@ -1,47 +1,206 @@
 package jwasm
 
 import (
-	"encoding/binary"
 	"fmt"
 	"io"
 	"math/big"
 )
 
+// https://webassembly.github.io/spec/core/binary/values.html#integers
+//
+// All integers are encoded using the LEB128 variable-length integer encoding, in either
+// unsigned or signed variant. The total number of bytes encoding a value of type uN or sN
+// must not exceed ceil(N/7) bytes, and unused bits in the last byte must be 0 for unsigned
+// and equal to the sign bit for signed integers.
+//
+// The fixed-width readers below decode directly into integers. They do not allocate if r
+// implements io.ByteReader, as the readers of the decoder do, other readers are wrapped.
+
 func ReadUint8(r io.Reader) (uint8, error) {
-	value, err := ReadUleb128(r)
+	value, err := readUnsigned(byteReader(r), 8)
 	if err != nil {
 		return 0, fmt.Errorf("reading uleb128 for uint8 failed: %w", err)
 	}
 
-	if value.BitLen() > 8 {
-		return 0, fmt.Errorf("uleb128 is too large for an uint8: %s", value)
+	return uint8(value), nil
+}
+
+func ReadUint32(r io.Reader) (uint32, error) {
+	value, err := readUnsigned(byteReader(r), 32)
+	if err != nil {
+		return 0, fmt.Errorf("reading uleb128 for uint32 failed: %w", err)
 	}
 
-	return uint8(value.Uint64()), nil
+	return uint32(value), nil
 }
 
-func ReadUint32(r io.Reader) (uint32, error) {
-	value, err := ReadUleb128(r)
+func ReadInt32(r io.Reader) (int32, error) {
+	value, err := readSigned(byteReader(r), 32)
 	if err != nil {
-		return 0, fmt.Errorf("reading uleb128 for uint8 failed: %w", err)
+		return 0, fmt.Errorf("reading sleb128 for int32 failed: %w", err)
+	}
+
+	return int32(value), nil
+}
+
+// ReadInt33 reads a signed 33 bit integer as it is used to encode type indices in block types.
+func ReadInt33(r io.Reader) (int64, error) {
+	value, err := readSigned(byteReader(r), 33)
+	if err != nil {
+		return 0, fmt.Errorf("reading sleb128 for int33 failed: %w", err)
+	}
+
+	return value, nil
+}
+
+func ReadInt64(r io.Reader) (int64, error) {
+	value, err := readSigned(byteReader(r), 64)
+	if err != nil {
+		return 0, fmt.Errorf("reading sleb128 for int64 failed: %w", err)
+	}
+
+	return value, nil
+}
+
+// byteReader returns r as an io.ByteReader. Readers that do not implement it are wrapped,
+// which allocates once per call instead of once per byte.
+func byteReader(r io.Reader) io.ByteReader {
+	if br, ok := r.(io.ByteReader); ok {
+		return br
+	}
+
+	return &singleByteReader{r: r}
+}
+
+type singleByteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (r *singleByteReader) ReadByte() (byte, error) {
+	_, err := io.ReadFull(r.r, r.buf[:])
+	return r.buf[0], err
+}
+
+func readByte(r io.Reader) (byte, error) {
+	return byteReader(r).ReadByte()
+}
+
+// readLittleEndian reads an integer of n bytes in little endian byte order, which is how
+// floating point values are encoded.
+func readLittleEndian(r io.Reader, n int) (uint64, error) {
+	br := byteReader(r)
+	var result uint64
+
+	for i := 0; i < n; i++ {
+		b, err := br.ReadByte()
+		if err == io.EOF && i > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		result |= uint64(b) << (8 * i)
+	}
+
+	return result, nil
+}
+
+// readUnsigned reads an unsigned LEB128 encoded integer of the given bit width.
+func readUnsigned(r io.ByteReader, bits uint) (uint64, error) {
+	var result uint64
+	var shift uint
+	maxBytes := (bits + 6) / 7
+
+	for i := uint(1); ; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("reading uleb128 byte failed: %w", err)
+		}
+
+		if i == maxBytes {
+			if b&0b10000000 != 0 {
+				return 0, fmt.Errorf("%w: integer representation too long, more than [%d] bytes for u%d", ErrMalformedLEB, maxBytes, bits)
+			}
+
+			// The bits of the last byte that do not fit into the integer must be zero
+			if b>>(bits-shift) != 0 {
+				return 0, fmt.Errorf("%w: integer too large for u%d, last byte [0x%x]", ErrMalformedLEB, bits, b)
+			}
+		}
+
+		result |= uint64(b&0b01111111) << shift
+
+		// If highest bit is not set, then we read the last byte for this LEB128
+		if b&0b10000000 == 0 {
+			return result, nil
+		}
+
+		shift += 7
 	}
+}
 
-	if value.BitLen() > 32 {
-		return 0, fmt.Errorf("uleb128 is too large for an uint8: %s", value)
+// readSigned reads a signed LEB128 encoded integer of the given bit width and sign extends it.
+func readSigned(r io.ByteReader, bits uint) (int64, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, fmt.Errorf("reading sleb128 byte failed: %w", err)
 	}
 
-	return uint32(value.Uint64()), nil
+	return readSignedFrom(r, b, bits)
+}
+
+// readSignedFrom reads a signed LEB128 encoded integer like readSigned, whose first byte b
+// was already read from r.
+func readSignedFrom(r io.ByteReader, b byte, bits uint) (int64, error) {
+	var result int64
+	var shift uint
+	maxBytes := (bits + 6) / 7
+
+	for i := uint(1); ; i++ {
+		if i > 1 {
+			var err error
+			b, err = r.ReadByte()
+			if err != nil {
+				return 0, fmt.Errorf("reading sleb128 byte failed: %w", err)
+			}
+		}
+
+		if i == maxBytes {
+			if b&0b10000000 != 0 {
+				return 0, fmt.Errorf("%w: integer representation too long, more than [%d] bytes for s%d", ErrMalformedLEB, maxBytes, bits)
+			}
+
+			// The sign bit and the bits of the last byte that do not fit into the integer
+			// must either be all zero or all one
+			mask := byte(0b01111111) &^ (1<<(bits-shift-1) - 1)
+			if b&mask != 0 && b&mask != mask {
+				return 0, fmt.Errorf("%w: integer too large for s%d, last byte [0x%x]", ErrMalformedLEB, bits, b)
+			}
+		}
+
+		result |= int64(b&0b01111111) << shift
+		shift += 7
+
+		// If highest bit is not set, then we read the last byte for this LEB128
+		if b&0b10000000 == 0 {
+			// If the sign bit of the last byte is set, then the value is negative
+			if shift < 64 && b&0b01000000 != 0 {
+				result |= -1 << shift
+			}
+			return result, nil
+		}
+	}
 }
 
+// ReadUleb128 reads an unsigned LEB128 encoded integer of arbitrary size.
 func ReadUleb128(r io.Reader) (*big.Int, error) {
 	result := new(big.Int)
 	var bytesRead uint
 
 	for {
-		var b uint8
-
-		err := binary.Read(r, binary.LittleEndian, &b)
-
+		b, err := readByte(r)
 		if err != nil {
 			return nil, fmt.Errorf("reading uleb128 byte failed: %w", err)
 		}
@@ -63,6 +222,63 @@ func ReadUleb128(r io.Reader) (*big.Int, error) {
 	return result, nil
 }
 
-// func ReadSleb128(r io.Reader) (uint, error) {
-//
-// }
+// The writers encode integers in their shortest LEB128 representation, which is the
+// representation the readers above accept for every value.
+
+func WriteUint32(w io.Writer, value uint32) error {
+	var buf [5]byte
+	_, err := w.Write(appendUnsigned(buf[:0], uint64(value)))
+	return err
+}
+
+func WriteInt32(w io.Writer, value int32) error {
+	var buf [5]byte
+	_, err := w.Write(appendSigned(buf[:0], int64(value)))
+	return err
+}
+
+func WriteInt64(w io.Writer, value int64) error {
+	var buf [10]byte
+	_, err := w.Write(appendSigned(buf[:0], value))
+	return err
+}
+
+// appendUnsigned appends the unsigned LEB128 encoding of value to b.
+func appendUnsigned(b []byte, value uint64) []byte {
+	for {
+		current := byte(value & 0b01111111)
+		value >>= 7
+
+		if value == 0 {
+			return append(b, current)
+		}
+
+		b = append(b, current|0b10000000)
+	}
+}
+
+// appendPaddedUnsigned appends the unsigned LEB128 encoding of value padded to width bytes,
+// or its shortest encoding if that is longer.
+func appendPaddedUnsigned(b []byte, value uint64, width int) []byte {
+	for i := 1; i < width; i++ {
+		b = append(b, byte(value&0b01111111)|0b10000000)
+		value >>= 7
+	}
+	return appendUnsigned(b, value)
+}
+
+// appendSigned appends the signed LEB128 encoding of value to b.
+func appendSigned(b []byte, value int64) []byte {
+	for {
+		current := byte(value & 0b01111111)
+		value >>= 7
+
+		// The value is complete once the remaining bits are just the sign extension of
+		// the sign bit of the current byte
+		if (value == 0 && current&0b01000000 == 0) || (value == -1 && current&0b01000000 != 0) {
+			return append(b, current)
+		}
+
+		b = append(b, current|0b10000000)
+	}
+}
@@ -3,7 +3,10 @@ package jwasm_test
 import (
 	"bytes"
 	"encoding/hex"
+	"io"
+	"math"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/jcklie/jwasm"
@@ -48,3 +51,172 @@ func TestUnsigned(t *testing.T) {
 		})
 	}
 }
+
+func TestSignedFixedWidth(t *testing.T) {
+	for _, test := range []struct {
+		Hex     string
+		Int32   int64
+		Int33   int64
+		Int64   int64
+		Fails32 bool
+		Fails33 bool
+	}{
+		{Hex: "00", Int32: 0, Int33: 0, Int64: 0},
+		{Hex: "7F", Int32: -1, Int33: -1, Int64: -1},
+		{Hex: "02", Int32: 2, Int33: 2, Int64: 2},
+		{Hex: "7E", Int32: -2, Int33: -2, Int64: -2},
+		{Hex: "FF00", Int32: 127, Int33: 127, Int64: 127},
+		{Hex: "817F", Int32: -127, Int33: -127, Int64: -127},
+		{Hex: "8001", Int32: 128, Int33: 128, Int64: 128},
+		{Hex: "807F", Int32: -128, Int33: -128, Int64: -128},
+		{Hex: "C0BB78", Int32: -123456, Int33: -123456, Int64: -123456},
+		{Hex: "FFFFFFFF07", Int32: 2147483647, Int33: 2147483647, Int64: 2147483647},
+		{Hex: "8080808078", Int32: -2147483648, Int33: -2147483648, Int64: -2147483648},
+		{Hex: "8080808008", Int33: 2147483648, Int64: 2147483648, Fails32: true},
+		{Hex: "FFFFFFFF0F", Int33: 4294967295, Int64: 4294967295, Fails32: true},
+		{Hex: "8080808070", Int33: -4294967296, Int64: -4294967296, Fails32: true},
+		{Hex: "8080808010", Int64: 4294967296, Fails32: true, Fails33: true},
+		{Hex: "FFFFFFFFFFFFFFFFFF00", Int64: 9223372036854775807, Fails32: true, Fails33: true},
+		{Hex: "8080808080808080807F", Int64: -9223372036854775808, Fails32: true, Fails33: true},
+	} {
+		t.Run(test.Hex, func(t *testing.T) {
+			buf, err := hex.DecodeString(test.Hex)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual32, err := jwasm.ReadInt32(bytes.NewReader(buf))
+			if test.Fails32 {
+				if err == nil {
+					t.Errorf("expected int32 to fail, got %d", actual32)
+				}
+			} else if err != nil || int64(actual32) != test.Int32 {
+				t.Errorf("int32: expected %d, got %d (%v)", test.Int32, actual32, err)
+			}
+
+			actual33, err := jwasm.ReadInt33(bytes.NewReader(buf))
+			if test.Fails33 {
+				if err == nil {
+					t.Errorf("expected int33 to fail, got %d", actual33)
+				}
+			} else if err != nil || actual33 != test.Int33 {
+				t.Errorf("int33: expected %d, got %d (%v)", test.Int33, actual33, err)
+			}
+
+			actual64, err := jwasm.ReadInt64(bytes.NewReader(buf))
+			if err != nil || actual64 != test.Int64 {
+				t.Errorf("int64: expected %d, got %d (%v)", test.Int64, actual64, err)
+			}
+		})
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	for _, test := range []struct {
+		Name string
+		Hex  string
+		Read func(r io.Reader) error
+	}{
+		{"uint8 too long", "808000", func(r io.Reader) error { _, err := jwasm.ReadUint8(r); return err }},
+		{"uint8 too large", "FF03", func(r io.Reader) error { _, err := jwasm.ReadUint8(r); return err }},
+		{"uint32 too long", "808080808000", func(r io.Reader) error { _, err := jwasm.ReadUint32(r); return err }},
+		{"uint32 too large", "FFFFFFFF1F", func(r io.Reader) error { _, err := jwasm.ReadUint32(r); return err }},
+		{"uint32 unexpected end", "8080", func(r io.Reader) error { _, err := jwasm.ReadUint32(r); return err }},
+		{"int32 too long", "FFFFFFFFFF7F", func(r io.Reader) error { _, err := jwasm.ReadInt32(r); return err }},
+		{"int32 unused bits not sign", "FFFFFFFF4F", func(r io.Reader) error { _, err := jwasm.ReadInt32(r); return err }},
+		{"int64 too long", "FFFFFFFFFFFFFFFFFFFF7F", func(r io.Reader) error { _, err := jwasm.ReadInt64(r); return err }},
+		{"int64 unused bits not sign", "FFFFFFFFFFFFFFFFFF01", func(r io.Reader) error { _, err := jwasm.ReadInt64(r); return err }},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			buf, err := hex.DecodeString(test.Hex)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := test.Read(bytes.NewReader(buf)); err == nil {
+				t.Errorf("%s: expected decoding [%s] to fail", test.Name, test.Hex)
+			}
+		})
+	}
+}
+
+func TestFixedWidthDoesNotAllocate(t *testing.T) {
+	buf, err := hex.DecodeString("E58E26C0BB78FFFFFFFFFFFFFFFFFF008080808070")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := bytes.NewReader(buf)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		r.Reset(buf)
+		_, _ = jwasm.ReadUint32(r)
+		_, _ = jwasm.ReadInt32(r)
+		_, _ = jwasm.ReadInt64(r)
+		_, _ = jwasm.ReadInt33(r)
+	})
+
+	if allocs != 0 {
+		t.Errorf("expected no allocations, got %f", allocs)
+	}
+}
+
+func TestFixedWidthWrapsReaders(t *testing.T) {
+	// io.MultiReader does not implement io.ByteReader
+	r := io.MultiReader(bytes.NewReader([]byte{0xE5, 0x8E}), bytes.NewReader([]byte{0x26, 0x7F}))
+
+	value, err := jwasm.ReadUint32(r)
+	if err != nil || value != 624485 {
+		t.Errorf("uint32: expected 624485, got %d (%v)", value, err)
+	}
+
+	signed, err := jwasm.ReadInt64(r)
+	if err != nil || signed != -1 {
+		t.Errorf("int64: expected -1, got %d (%v)", signed, err)
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	for _, value := range []int64{0, 1, -1, 63, 64, -64, -65, 127, 128, 624485, -123456, math.MaxInt32, math.MinInt32, math.MaxInt64, math.MinInt64} {
+		var buf bytes.Buffer
+
+		if value >= 0 && value <= math.MaxUint32 {
+			buf.Reset()
+			if err := jwasm.WriteUint32(&buf, uint32(value)); err != nil {
+				t.Fatal(err)
+			}
+			if actual, err := jwasm.ReadUint32(&buf); err != nil || int64(actual) != value {
+				t.Errorf("uint32: expected %d, got %d (%v)", value, actual, err)
+			}
+		}
+
+		if value >= math.MinInt32 && value <= math.MaxInt32 {
+			buf.Reset()
+			if err := jwasm.WriteInt32(&buf, int32(value)); err != nil {
+				t.Fatal(err)
+			}
+			if actual, err := jwasm.ReadInt32(&buf); err != nil || int64(actual) != value {
+				t.Errorf("int32: expected %d, got %d (%v)", value, actual, err)
+			}
+		}
+
+		buf.Reset()
+		if err := jwasm.WriteInt64(&buf, value); err != nil {
+			t.Fatal(err)
+		}
+		if actual, err := jwasm.ReadInt64(&buf); err != nil || actual != value {
+			t.Errorf("int64: expected %d, got %d (%v)", value, actual, err)
+		}
+	}
+}
+
+func TestWriteShortestEncoding(t *testing.T) {
+	var buf bytes.Buffer
+
+	_ = jwasm.WriteUint32(&buf, 624485)
+	_ = jwasm.WriteInt32(&buf, -123456)
+	_ = jwasm.WriteInt64(&buf, 127)
+
+	if actual := strings.ToUpper(hex.EncodeToString(buf.Bytes())); actual != "E58E26C0BB78FF00" {
+		t.Errorf("expected E58E26C0BB78FF00, got %s", actual)
+	}
+}
@@ -0,0 +1,744 @@
+package jwasm
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Lexical Format
+// https://webassembly.github.io/spec/core/text/lexical.html
+
+type tokenKind int
+
+const (
+	tokenLeftParen tokenKind = iota
+	tokenRightParen
+	tokenKeyword
+	tokenNumber
+	tokenString
+	tokenId
+	tokenReserved
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenLeftParen:
+		return "("
+	case tokenRightParen:
+		return ")"
+	case tokenKeyword:
+		return "keyword"
+	case tokenNumber:
+		return "number"
+	case tokenString:
+		return "string"
+	case tokenId:
+		return "identifier"
+	default:
+		return "reserved"
+	}
+}
+
+// position is the 1-based line and column of a token.
+type position struct {
+	line   int
+	column int
+}
+
+type token struct {
+	kind tokenKind
+	// text is the token as it appears in the source, except for strings where it holds
+	// the decoded bytes.
+	text string
+	pos  position
+}
+
+func (t token) String() string {
+	if t.kind == tokenString {
+		return strconv.Quote(t.text)
+	}
+	return t.text
+}
+
+type lexer struct {
+	src string
+	i   int
+	pos position
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, pos: position{1, 1}}
+}
+
+// advance moves n bytes forward, keeping track of lines and columns.
+func (l *lexer) advance(n int) {
+	for _, c := range []byte(l.src[l.i : l.i+n]) {
+		if c == '\n' {
+			l.pos.line++
+			l.pos.column = 1
+		} else if c < 0x80 || c >= 0xC0 {
+			// Continuation bytes of UTF-8 sequences do not start a new column
+			l.pos.column++
+		}
+	}
+	l.i += n
+}
+
+// next returns the next token, or false at the end of the source.
+func (l *lexer) next() (token, bool, error) {
+	err := l.skipWhitespace()
+	if err != nil {
+		return token{}, false, err
+	}
+
+	if l.i >= len(l.src) {
+		return token{}, false, nil
+	}
+
+	start := l.pos
+	switch c := l.src[l.i]; {
+	case c == '(':
+		l.advance(1)
+		return token{tokenLeftParen, "(", start}, true, nil
+	case c == ')':
+		l.advance(1)
+		return token{tokenRightParen, ")", start}, true, nil
+	case c == '"' || isIdChar(c):
+		t, err := l.atom()
+		if err != nil {
+			return token{}, false, err
+		}
+		return t, true, nil
+	default:
+		return token{}, false, syntaxErrorf(start, ErrUnexpectedToken, "unexpected character [%q]", c)
+	}
+}
+
+// atom lexes a string, identifier, keyword or number. Tokens have to be separated by white
+// space or parentheses, characters and strings without separation form a reserved token,
+// which is an unknown operator, as do malformed numbers.
+func (l *lexer) atom() (token, error) {
+	start := l.pos
+	begin := l.i
+
+	var t token
+	if c := l.src[l.i]; c == '"' {
+		text, err := l.string()
+		if err != nil {
+			return token{}, err
+		}
+		t = token{tokenString, text, start}
+	} else {
+		n := 0
+		for l.i+n < len(l.src) && isIdChar(l.src[l.i+n]) {
+			n++
+		}
+		text := l.src[l.i : l.i+n]
+		l.advance(n)
+
+		switch {
+		case c == '$' && n > 1:
+			t = token{tokenId, text, start}
+		case 'a' <= c && c <= 'z':
+			t = token{tokenKeyword, text, start}
+		case ('0' <= c && c <= '9' || c == '+' || c == '-') && isNumber(text):
+			t = token{tokenNumber, text, start}
+		default:
+			t = token{tokenReserved, text, start}
+		}
+	}
+
+	for l.i < len(l.src) && (l.src[l.i] == '"' || isIdChar(l.src[l.i])) {
+		if l.src[l.i] == '"' {
+			if _, err := l.string(); err != nil {
+				return token{}, err
+			}
+		} else {
+			l.advance(1)
+		}
+		t = token{tokenReserved, l.src[begin:l.i], start}
+	}
+
+	if t.kind == tokenReserved {
+		return token{}, syntaxErrorf(start, ErrUnknownOperator, "reserved token [%s]", t.text)
+	}
+	return t, nil
+}
+
+func (l *lexer) skipWhitespace() error {
+	// https://webassembly.github.io/spec/core/text/lexical.html#white-space
+	for l.i < len(l.src) {
+		switch {
+		case strings.IndexByte(" \t\n\r", l.src[l.i]) >= 0:
+			l.advance(1)
+		case strings.HasPrefix(l.src[l.i:], ";;"):
+			// Line comments end at a line feed or a carriage return
+			n := strings.IndexAny(l.src[l.i:], "\n\r")
+			if n < 0 {
+				n = len(l.src) - l.i
+			}
+			l.advance(n)
+		case strings.HasPrefix(l.src[l.i:], "(;"):
+			err := l.blockComment()
+			if err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// blockComment skips a block comment, which may be nested.
+func (l *lexer) blockComment() error {
+	start := l.pos
+	depth := 0
+	for l.i < len(l.src) {
+		switch {
+		case strings.HasPrefix(l.src[l.i:], "(;"):
+			depth++
+			l.advance(2)
+		case strings.HasPrefix(l.src[l.i:], ";)"):
+			depth--
+			l.advance(2)
+			if depth == 0 {
+				return nil
+			}
+		default:
+			l.advance(1)
+		}
+	}
+	return syntaxErrorf(start, ErrUnexpectedEOF, "unclosed block comment")
+}
+
+func (l *lexer) string() (string, error) {
+	// https://webassembly.github.io/spec/core/text/values.html#strings
+	start := l.pos
+	l.advance(1)
+
+	var b strings.Builder
+	for {
+		if l.i >= len(l.src) {
+			return "", syntaxErrorf(start, ErrUnexpectedEOF, "unclosed string")
+		}
+
+		c := l.src[l.i]
+		switch {
+		case c == '"':
+			l.advance(1)
+			return b.String(), nil
+		case c == '\\':
+			err := l.escape(&b)
+			if err != nil {
+				return "", err
+			}
+		case c < 0x20 || c == 0x7F:
+			return "", syntaxErrorf(l.pos, ErrUnexpectedToken, "control character [0x%x] in string", c)
+		default:
+			r, size := utf8.DecodeRuneInString(l.src[l.i:])
+			if r == utf8.RuneError && size <= 1 {
+				return "", syntaxErrorf(l.pos, ErrUnexpectedToken, "malformed UTF-8 encoding in string")
+			}
+			b.WriteString(l.src[l.i : l.i+size])
+			l.advance(size)
+		}
+	}
+}
+
+func (l *lexer) escape(b *strings.Builder) error {
+	pos := l.pos
+	if l.i+1 >= len(l.src) {
+		return syntaxErrorf(pos, ErrUnexpectedEOF, "unclosed string")
+	}
+
+	switch c := l.src[l.i+1]; c {
+	case 't':
+		b.WriteByte('\t')
+	case 'n':
+		b.WriteByte('\n')
+	case 'r':
+		b.WriteByte('\r')
+	case '"', '\'', '\\':
+		b.WriteByte(c)
+	case 'u':
+		end := strings.IndexByte(l.src[l.i:], '}')
+		if !strings.HasPrefix(l.src[l.i+2:], "{") || end < 0 {
+			return syntaxErrorf(pos, ErrUnexpectedToken, "malformed unicode escape")
+		}
+
+		digits := l.src[l.i+3 : l.i+end]
+		value, err := parseHexDigits(digits)
+		if err != nil || value > utf8.MaxRune || (value >= 0xD800 && value < 0xE000) {
+			return syntaxErrorf(pos, ErrUnexpectedToken, "malformed unicode escape [%s]", digits)
+		}
+
+		b.WriteRune(rune(value))
+		l.advance(end + 1)
+		return nil
+	default:
+		if l.i+2 >= len(l.src) || !isHexDigit(c) || !isHexDigit(l.src[l.i+2]) {
+			return syntaxErrorf(pos, ErrUnexpectedToken, "unknown escape sequence")
+		}
+
+		value, _ := strconv.ParseUint(l.src[l.i+1:l.i+3], 16, 8)
+		b.WriteByte(byte(value))
+		l.advance(3)
+		return nil
+	}
+
+	l.advance(2)
+	return nil
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// parseHexDigits parses hexadecimal digits that may be separated by single underscores.
+func parseHexDigits(digits string) (uint64, error) {
+	if !validDigits(digits, isHexDigit) {
+		return 0, fmt.Errorf("malformed hexadecimal number [%s]", digits)
+	}
+	return strconv.ParseUint(strings.ReplaceAll(digits, "_", ""), 16, 64)
+}
+
+// isNumber checks the syntax of a number token, which is a signed or unsigned integer or a
+// float, but not whether it fits into any type.
+func isNumber(text string) bool {
+	magnitude := text
+	if text[0] == '+' || text[0] == '-' {
+		magnitude = text[1:]
+	}
+
+	isDecimal := func(c byte) bool { return '0' <= c && c <= '9' }
+	switch {
+	case magnitude == "inf", magnitude == "nan":
+		return true
+	case strings.HasPrefix(magnitude, "nan:0x"):
+		return validDigits(magnitude[len("nan:0x"):], isHexDigit)
+	case strings.HasPrefix(magnitude, "0x") && validDigits(magnitude[len("0x"):], isHexDigit):
+		return true
+	case validDigits(magnitude, isDecimal):
+		return true
+	}
+
+	_, ok := normalizeFloat(magnitude)
+	return ok
+}
+
+// validDigits checks that digits is not empty and that underscores only appear between digits.
+func validDigits(digits string, isDigit func(byte) bool) bool {
+	if digits == "" {
+		return false
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] == '_' {
+			if i == 0 || i == len(digits)-1 || digits[i-1] == '_' {
+				return false
+			}
+		} else if !isDigit(digits[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// S-Expressions
+
+// sexpr is either a single token or a parenthesized list of s-expressions.
+type sexpr struct {
+	// token is the atom, or the opening parenthesis of a list
+	token    token
+	list     bool
+	children []*sexpr
+}
+
+// isKeyword returns true if s is the keyword kw.
+func (s *sexpr) isKeyword(kw string) bool {
+	return !s.list && s.token.kind == tokenKeyword && s.token.text == kw
+}
+
+// isList returns true if s is a list starting with the keyword kw.
+func (s *sexpr) isList(kw string) bool {
+	return s.list && len(s.children) > 0 && s.children[0].isKeyword(kw)
+}
+
+func (s *sexpr) String() string {
+	if !s.list {
+		return s.token.String()
+	}
+	if len(s.children) > 0 && !s.children[0].list {
+		return "(" + s.children[0].token.text + " ...)"
+	}
+	return "(...)"
+}
+
+// parseSExpressions reads all s-expressions of src.
+func parseSExpressions(src string) ([]*sexpr, error) {
+	l := newLexer(src)
+
+	var stack []*sexpr
+	var result []*sexpr
+	for {
+		t, ok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			if len(stack) > 0 {
+				return nil, syntaxErrorf(stack[len(stack)-1].token.pos, ErrUnexpectedEOF, "unclosed parenthesis")
+			}
+			return result, nil
+		}
+
+		switch t.kind {
+		case tokenLeftParen:
+			stack = append(stack, &sexpr{token: t, list: true})
+			continue
+		case tokenRightParen:
+			if len(stack) == 0 {
+				return nil, syntaxErrorf(t.pos, ErrUnexpectedToken, "unbalanced parenthesis")
+			}
+
+			list := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				result = append(result, list)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, list)
+			}
+		default:
+			atom := &sexpr{token: t}
+			if len(stack) == 0 {
+				result = append(result, atom)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, atom)
+			}
+		}
+	}
+}
+
+// cursor walks the children of a list.
+type cursor struct {
+	list  *sexpr
+	items []*sexpr
+	i     int
+}
+
+// newCursor returns a cursor over the children of list, skipping the leading keyword.
+func newCursor(list *sexpr) *cursor {
+	return &cursor{list: list, items: list.children[1:]}
+}
+
+func (c *cursor) done() bool {
+	return c.i >= len(c.items)
+}
+
+func (c *cursor) peek() *sexpr {
+	if c.done() {
+		return nil
+	}
+	return c.items[c.i]
+}
+
+func (c *cursor) next() *sexpr {
+	item := c.peek()
+	if item != nil {
+		c.i++
+	}
+	return item
+}
+
+// peekKind returns true if the next item is a token of the given kind.
+func (c *cursor) peekKind(kind tokenKind) bool {
+	item := c.peek()
+	return item != nil && !item.list && item.token.kind == kind
+}
+
+// peekList returns true if the next item is a list starting with the keyword kw.
+func (c *cursor) peekList(kw string) bool {
+	item := c.peek()
+	return item != nil && item.isList(kw)
+}
+
+// keyword consumes the next item if it is the keyword kw.
+func (c *cursor) keyword(kw string) bool {
+	item := c.peek()
+	if item != nil && item.isKeyword(kw) {
+		c.i++
+		return true
+	}
+	return false
+}
+
+// id consumes the next item if it is an identifier and returns it.
+func (c *cursor) id() string {
+	if c.peekKind(tokenId) {
+		return c.next().token.text
+	}
+	return ""
+}
+
+// position returns the position of the next item, or of the end of the list.
+func (c *cursor) position() position {
+	if item := c.peek(); item != nil {
+		return item.token.pos
+	}
+	if len(c.items) > 0 {
+		return c.items[len(c.items)-1].token.pos
+	}
+	return c.list.token.pos
+}
+
+func (c *cursor) errorf(err error, format string, args ...any) *SyntaxError {
+	return syntaxErrorf(c.position(), err, format, args...)
+}
+
+func syntaxErrorf(pos position, err error, format string, args ...any) *SyntaxError {
+	return &SyntaxError{pos.line, pos.column, fmt.Errorf("%w, %s", err, fmt.Sprintf(format, args...))}
+}
+
+// unexpected reports the next item, or a missing item at the end of the list.
+func (c *cursor) unexpected(expected string) *SyntaxError {
+	if c.done() {
+		return c.errorf(ErrUnexpectedToken, "expected %s, got end of %s", expected, c.list)
+	}
+	return c.errorf(ErrUnexpectedToken, "expected %s, got [%s]", expected, c.peek())
+}
+
+// end reports remaining items.
+func (c *cursor) end() error {
+	if !c.done() {
+		return c.errorf(ErrUnexpectedToken, "expected end of %s, got [%s]", c.list, c.peek())
+	}
+	return nil
+}
+
+func (c *cursor) string() (string, error) {
+	if !c.peekKind(tokenString) {
+		return "", c.unexpected("string")
+	}
+	return c.next().token.text, nil
+}
+
+// name consumes a string that has to be valid UTF-8.
+func (c *cursor) name() (string, error) {
+	pos := c.position()
+	s, err := c.string()
+	if err != nil {
+		return "", err
+	}
+	if !utf8.ValidString(s) {
+		return "", syntaxErrorf(pos, ErrMalformedUTF8, "in name %q", s)
+	}
+	return s, nil
+}
+
+// Numbers
+// https://webassembly.github.io/spec/core/text/values.html#integers
+
+// unsigned consumes an unsigned integer of the given bit width.
+func (c *cursor) unsigned(bits int) (uint64, error) {
+	if !c.peekKind(tokenNumber) {
+		return 0, c.unexpected("unsigned integer")
+	}
+
+	value, err := parseUnsigned(c.peek().token.text, bits)
+	if err != nil {
+		return 0, c.errorf(ErrConstantOutOfRange, "%v", err)
+	}
+	c.next()
+	return value, nil
+}
+
+func (c *cursor) uint32() (uint32, error) {
+	value, err := c.unsigned(32)
+	return uint32(value), err
+}
+
+// integer consumes a signed or unsigned integer of the given bit width and returns its
+// two's complement bit pattern.
+func (c *cursor) integer(bits int) (uint64, error) {
+	if !c.peekKind(tokenNumber) {
+		return 0, c.unexpected("integer")
+	}
+
+	value, err := parseInteger(c.peek().token.text, bits)
+	if err != nil {
+		return 0, c.errorf(ErrConstantOutOfRange, "%v", err)
+	}
+	c.next()
+	return value, nil
+}
+
+// float consumes a floating-point number of the given bit width and returns its bit pattern.
+func (c *cursor) float(bits int) (uint64, error) {
+	item := c.peek()
+	if item == nil || item.list || (item.token.kind != tokenNumber && item.token.kind != tokenKeyword) {
+		return 0, c.unexpected("float")
+	}
+
+	// Scripts match results against these NaN patterns, they are not constants
+	switch strings.TrimLeft(item.token.text, "+-") {
+	case "nan:canonical", "nan:arithmetic":
+		return 0, c.unexpected("float")
+	}
+
+	// Only inf and nan are floats among the keywords
+	if item.token.kind == tokenKeyword && !isNumber(item.token.text) {
+		return 0, c.errorf(ErrUnknownOperator, "[%s]", item.token.text)
+	}
+
+	value, err := parseFloat(item.token.text, bits)
+	if err != nil {
+		return 0, c.errorf(ErrConstantOutOfRange, "%v", err)
+	}
+	c.next()
+	return value, nil
+}
+
+func parseUnsigned(text string, bits int) (uint64, error) {
+	var value uint64
+	var err error
+	if digits, ok := strings.CutPrefix(text, "0x"); ok {
+		value, err = parseHexDigits(digits)
+	} else if validDigits(text, func(c byte) bool { return '0' <= c && c <= '9' }) {
+		value, err = strconv.ParseUint(strings.ReplaceAll(text, "_", ""), 10, 64)
+	} else {
+		err = fmt.Errorf("malformed integer [%s]", text)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("malformed integer [%s]", text)
+	}
+
+	if bits < 64 && value >= 1<<bits {
+		return 0, fmt.Errorf("integer [%s] does not fit into %d bits", text, bits)
+	}
+
+	return value, nil
+}
+
+func parseInteger(text string, bits int) (uint64, error) {
+	// https://webassembly.github.io/spec/core/text/values.html#integers
+	//
+	// Uninterpreted integers can be written as either signed or unsigned, and are
+	// normalized to unsigned in the abstract syntax.
+	sign := text[0]
+	if sign != '+' && sign != '-' {
+		return parseUnsigned(text, bits)
+	}
+
+	magnitude, err := parseUnsigned(text[1:], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	limit := uint64(1) << (bits - 1)
+	if sign == '+' {
+		if magnitude >= limit {
+			return 0, fmt.Errorf("integer [%s] does not fit into %d bits", text, bits)
+		}
+		return magnitude, nil
+	}
+
+	if magnitude > limit {
+		return 0, fmt.Errorf("integer [%s] does not fit into %d bits", text, bits)
+	}
+
+	value := -magnitude
+	if bits < 64 {
+		value &= 1<<bits - 1
+	}
+	return value, nil
+}
+
+func parseFloat(text string, bits int) (uint64, error) {
+	// https://webassembly.github.io/spec/core/text/values.html#floating-point
+	mantissaBits := 52
+	if bits == 32 {
+		mantissaBits = 23
+	}
+	exponentMask := uint64(1)<<(bits-mantissaBits-1) - 1
+
+	var signBit uint64
+	magnitude := text
+	if text[0] == '+' || text[0] == '-' {
+		magnitude = text[1:]
+		if text[0] == '-' {
+			signBit = 1 << (bits - 1)
+		}
+	}
+
+	switch {
+	case magnitude == "inf":
+		return signBit | exponentMask<<mantissaBits, nil
+	case magnitude == "nan":
+		return signBit | exponentMask<<mantissaBits | 1<<(mantissaBits-1), nil
+	case strings.HasPrefix(magnitude, "nan:0x"):
+		payload, err := parseHexDigits(magnitude[len("nan:0x"):])
+		if err != nil || payload == 0 || payload >= 1<<mantissaBits {
+			return 0, fmt.Errorf("malformed NaN payload [%s]", text)
+		}
+		return signBit | exponentMask<<mantissaBits | payload, nil
+	}
+
+	normalized, ok := normalizeFloat(magnitude)
+	if !ok {
+		return 0, fmt.Errorf("malformed float [%s]", text)
+	}
+
+	value, err := strconv.ParseFloat(normalized, bits)
+	if err != nil {
+		return 0, fmt.Errorf("float [%s] does not fit into f%d", text, bits)
+	}
+
+	if bits == 32 {
+		return signBit | uint64(math.Float32bits(float32(value))), nil
+	}
+	return signBit | math.Float64bits(value), nil
+}
+
+// normalizeFloat checks the syntax of an unsigned float and rewrites it into the syntax
+// accepted by strconv.ParseFloat.
+func normalizeFloat(text string) (string, bool) {
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	exponentChars := "eE"
+	prefix := ""
+	if digits, ok := strings.CutPrefix(text, "0x"); ok {
+		text = digits
+		isDigit = isHexDigit
+		exponentChars = "pP"
+		prefix = "0x"
+	}
+
+	mantissa, exponent := text, ""
+	if i := strings.IndexAny(text, exponentChars); i >= 0 {
+		mantissa, exponent = text[:i], text[i+1:]
+		digits := strings.TrimLeft(exponent, "+-")
+		if len(exponent)-len(digits) > 1 || !validDigits(digits, func(c byte) bool { return '0' <= c && c <= '9' }) {
+			return "", false
+		}
+	}
+
+	integer, fraction, hasFraction := strings.Cut(mantissa, ".")
+	if !validDigits(integer, isDigit) || (hasFraction && fraction != "" && !validDigits(fraction, isDigit)) {
+		return "", false
+	}
+
+	result := prefix + strings.ReplaceAll(mantissa, "_", "")
+	if exponent != "" {
+		result += exponentChars[:1] + strings.ReplaceAll(exponent, "_", "")
+	} else if prefix != "" {
+		// Hexadecimal floats require an exponent
+		result += "p0"
+	}
+	return result, true
+}
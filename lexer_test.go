@@ -0,0 +1,107 @@
+package jwasm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingIntegers(t *testing.T) {
+	tests := []struct {
+		text     string
+		bits     int
+		expected uint64
+	}{
+		{"0", 32, 0},
+		{"42", 32, 42},
+		{"+42", 32, 42},
+		{"-1", 32, 0xFFFFFFFF},
+		{"4294967295", 32, 0xFFFFFFFF},
+		{"-2147483648", 32, 0x80000000},
+		{"0x7fff_ffff", 32, 0x7FFFFFFF},
+		{"1_000_000", 64, 1000000},
+		{"-0x8000000000000000", 64, 0x8000000000000000},
+		{"18446744073709551615", 64, math.MaxUint64},
+	}
+
+	for _, test := range tests {
+		actual, err := parseInteger(test.text, test.bits)
+		if assert.NoError(t, err, test.text) {
+			assert.Equal(t, test.expected, actual, test.text)
+		}
+	}
+
+	for _, text := range []string{"4294967296", "-2147483649", "0x", "1__0", "_1", "1_", "0x1g"} {
+		_, err := parseInteger(text, 32)
+		assert.Error(t, err, text)
+	}
+}
+
+func TestParsingFloats(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected float64
+	}{
+		{"0", 0},
+		{"1.5", 1.5},
+		{"-0.25e1", -2.5},
+		{"1_000.000_1", 1000.0001},
+		{"0x1p-1", 0.5},
+		{"0x1.8", 1.5},
+		{"inf", math.Inf(1)},
+		{"-inf", math.Inf(-1)},
+	}
+
+	for _, test := range tests {
+		actual, err := parseFloat(test.text, 64)
+		if assert.NoError(t, err, test.text) {
+			assert.Equal(t, test.expected, math.Float64frombits(actual), test.text)
+		}
+	}
+
+	bits, err := parseFloat("-nan:0x200001", 32)
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint64(0xFFA00001), bits)
+	}
+
+	bits, err = parseFloat("nan", 64)
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint64(0x7FF8000000000000), bits)
+	}
+
+	_, err = parseFloat("nan:0x0", 32)
+	assert.Error(t, err)
+}
+
+func TestParsingSExpressions(t *testing.T) {
+	exprs, err := parseSExpressions("(module ;; comment\n  (; nested (; block ;) comment ;)\n  (data \"\\41\\u{e9}\\t\" $id))")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, exprs, 1) && assert.Len(t, exprs[0].children, 2) {
+		data := exprs[0].children[1]
+		assert.True(t, data.isList("data"))
+		assert.Equal(t, position{3, 3}, data.token.pos)
+		assert.Equal(t, token{tokenString, "A\u00e9\t", position{3, 9}}, data.children[1].token)
+		assert.Equal(t, token{tokenId, "$id", position{3, 23}}, data.children[2].token)
+	}
+
+	for _, src := range []string{"(module", "module)", "(data \"\\x\")", "(data \"a)", "(; unterminated"} {
+		_, err := parseSExpressions(src)
+		assert.ErrorAs(t, err, new(*SyntaxError), src)
+	}
+}
+
+func TestParsingSExpressionsRejectsReservedTokens(t *testing.T) {
+	for _, src := range []string{"(i32.const 0x)", "(i32.const 1a)", "($)", "(data \"a\"\"b\")", "(func $f\"x\")"} {
+		_, err := parseSExpressions(src)
+		assert.ErrorIs(t, err, ErrUnknownOperator, src)
+	}
+
+	exprs, err := parseSExpressions("(a ;; comment\rb)")
+	if assert.NoError(t, err) && assert.Len(t, exprs, 1) {
+		assert.Len(t, exprs[0].children, 2)
+	}
+}
@@ -0,0 +1,367 @@
+package jwasm
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Linker resolves the imports of modules by their module and name to host functions and
+// the exports of other instances. Its zero value has no definitions.
+// https://webassembly.github.io/spec/core/exec/modules.html#instantiation
+type Linker struct {
+	functions map[importName]*hostFunction
+	instances map[string]*Instance
+}
+
+type importName struct {
+	module string
+	name   string
+}
+
+func (n importName) String() string { return n.module + "." + n.name }
+
+// hostFunction is a Go function that can be imported by modules.
+// https://webassembly.github.io/spec/core/exec/runtime.html#function-instances
+type hostFunction struct {
+	name         importName
+	fn           reflect.Value
+	functionType FunctionType
+	// context and memory are set if fn takes a context.Context and a Memory, in this
+	// order, before the parameters of its function type
+	context bool
+	memory  bool
+	// err is set if the last result of fn is an error, which traps if it is not nil
+	err bool
+}
+
+var (
+	contextGoType = reflect.TypeFor[context.Context]()
+	memoryGoType  = reflect.TypeFor[Memory]()
+	errorGoType   = reflect.TypeFor[error]()
+)
+
+// DefineFunc defines the Go function fn as the function name of module. The parameters and
+// results of fn determine its function type: int32 and uint32 are i32, int64 and uint64 are
+// i64, float32 and float64 are f32 and f64 and interface types are externref. fn may take a
+// context.Context, which is the one passed to the call, and a Memory, which is the memory of
+// the calling module, as its first parameters. If the last result of fn is an error, a non
+// nil error traps.
+func (l *Linker) DefineFunc(module, name string, fn any) error {
+	h := &hostFunction{name: importName{module, name}, fn: reflect.ValueOf(fn)}
+
+	t := h.fn.Type()
+	if t.Kind() != reflect.Func {
+		return fmt.Errorf("defining function [%s] failed: expected a function, got %s", h.name, t)
+	}
+
+	parameters := 0
+	if t.NumIn() > parameters && t.In(parameters) == contextGoType {
+		h.context = true
+		parameters++
+	}
+	if t.NumIn() > parameters && t.In(parameters) == memoryGoType {
+		h.memory = true
+		parameters++
+	}
+
+	for i := parameters; i < t.NumIn(); i++ {
+		valueType, err := hostValueType(t.In(i))
+		if err != nil {
+			return fmt.Errorf("defining function [%s] failed: parameter [%d]: %w", h.name, i, err)
+		}
+		h.functionType.ParameterTypes = append(h.functionType.ParameterTypes, valueType)
+	}
+
+	results := t.NumOut()
+	if results > 0 && t.Out(results-1) == errorGoType {
+		h.err = true
+		results--
+	}
+
+	for i := 0; i < results; i++ {
+		valueType, err := hostValueType(t.Out(i))
+		if err != nil {
+			return fmt.Errorf("defining function [%s] failed: result [%d]: %w", h.name, i, err)
+		}
+		h.functionType.ResultTypes = append(h.functionType.ResultTypes, valueType)
+	}
+
+	if l.functions == nil {
+		l.functions = make(map[importName]*hostFunction)
+	}
+	l.functions[h.name] = h
+	return nil
+}
+
+// hostValueType returns the value type that represents values of the Go type t.
+func hostValueType(t reflect.Type) (ValueType, error) {
+	switch t.Kind() {
+	case reflect.Int32, reflect.Uint32:
+		return ValueTypeI32, nil
+	case reflect.Int64, reflect.Uint64:
+		return ValueTypeI64, nil
+	case reflect.Float32:
+		return ValueTypeF32, nil
+	case reflect.Float64:
+		return ValueTypeF64, nil
+	case reflect.Interface:
+		return ValueTypeExternRef, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// DefineInstance makes the exports of inst available for import from module. They take
+// precedence over the functions defined for the same module and name.
+func (l *Linker) DefineInstance(module string, inst *Instance) {
+	if l.instances == nil {
+		l.instances = make(map[string]*Instance)
+	}
+	l.instances[module] = inst
+}
+
+// Instantiate resolves the imports of m to the definitions of the linker and instantiates
+// m in s, which has to be the store of all instances defined in the linker. ctx is passed
+// to the host functions called by the start function. All imports without definition are
+// reported with ErrUnknownImport, imports of a different type with ErrIncompatibleImportType.
+// https://webassembly.github.io/spec/core/exec/modules.html#instantiation
+func (l *Linker) Instantiate(ctx context.Context, s *Store, m *Module) (*Instance, error) {
+	if err := Validate(m); err != nil {
+		return nil, fmt.Errorf("instantiating module failed: %w", err)
+	}
+
+	var imports []any
+	var unknown []string
+	for _, imp := range m.imports() {
+		name := importName{imp.module, imp.name}
+
+		extern, err := l.resolve(s, name)
+		if err != nil {
+			return nil, fmt.Errorf("instantiating module failed: %w", err)
+		}
+		if extern == nil {
+			unknown = append(unknown, name.String())
+			continue
+		}
+
+		if err := matchImport(m, name, imp.importDescription, extern); err != nil {
+			return nil, fmt.Errorf("instantiating module failed: %w", err)
+		}
+		imports = append(imports, extern)
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("instantiating module failed: %w: %s", ErrUnknownImport, strings.Join(unknown, ", "))
+	}
+
+	return s.instantiate(ctx, m, imports)
+}
+
+// resolve returns the function, table, memory or global instance of s that name is defined
+// as, or nil if it is not defined.
+func (l *Linker) resolve(s *Store, name importName) (any, error) {
+	if inst, ok := l.instances[name.module]; ok {
+		if extern, ok := inst.extern(name.name); ok {
+			if inst.store != s {
+				return nil, fmt.Errorf("resolving [%s] failed: instance belongs to another store", name)
+			}
+			return extern, nil
+		}
+	}
+
+	if h, ok := l.functions[name]; ok {
+		return s.allocateHostFunction(h), nil
+	}
+	return nil, nil
+}
+
+// matchImport checks that the instance extern matches the type of the import name of m.
+// Tables and memories match if their current size and maximum are within the imported limits.
+// https://webassembly.github.io/spec/core/exec/modules.html#import-matching
+func matchImport(m *Module, name importName, desc importDescription, extern any) error {
+	switch desc := desc.(type) {
+	case *importDescriptionFunc:
+		f, ok := extern.(*function)
+		if !ok {
+			break
+		}
+
+		functionType := m.TypeSection.FunctionTypes[desc.typeIndex]
+		if !equalFunctionTypes(functionType, f.functionType) {
+			return fmt.Errorf("%w: function [%s] is imported as %s -> %s, defined as %s -> %s",
+				ErrIncompatibleImportType, name,
+				valueTypesString(functionType.ParameterTypes), valueTypesString(functionType.ResultTypes),
+				valueTypesString(f.functionType.ParameterTypes), valueTypesString(f.functionType.ResultTypes))
+		}
+		return nil
+	case *importDescriptionTable:
+		t, ok := extern.(*tableInstance)
+		if !ok {
+			break
+		}
+
+		tableType := TableType{t.tableType.ElementType, Limits{uint32(len(t.elements)), t.tableType.Limits.Max}}
+		if tableType.ElementType != desc.tableType.ElementType || !matchLimits(tableType.Limits, desc.tableType.Limits) {
+			return fmt.Errorf("%w: table [%s] is imported as %s, defined as %s",
+				ErrIncompatibleImportType, name, tableTypeText(desc.tableType), tableTypeText(tableType))
+		}
+		return nil
+	case *importDescriptionMem:
+		memory, ok := extern.(*memoryInstance)
+		if !ok {
+			break
+		}
+
+		limits := Limits{memory.pages(), memory.memoryType.Limits.Max}
+		if !matchLimits(limits, desc.memoryType.Limits) {
+			return fmt.Errorf("%w: memory [%s] is imported as %s, defined as %s",
+				ErrIncompatibleImportType, name, limitsText(desc.memoryType.Limits), limitsText(limits))
+		}
+		return nil
+	case *importDescriptionGlobal:
+		g, ok := extern.(*globalInstance)
+		if !ok {
+			break
+		}
+
+		if g.globalType != desc.globalType {
+			return fmt.Errorf("%w: global [%s] is imported as %s, defined as %s",
+				ErrIncompatibleImportType, name, globalTypeText(desc.globalType), globalTypeText(g.globalType))
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s [%s] is defined as another kind of external value", ErrIncompatibleImportType, desc, name)
+}
+
+// matchLimits reports whether the limits of a table or memory are within the imported limits.
+// https://webassembly.github.io/spec/core/valid/types.html#match-limits
+func matchLimits(limits Limits, imported Limits) bool {
+	if limits.Min < imported.Min {
+		return false
+	}
+	if imported.Max == nil {
+		return true
+	}
+	return limits.Max != nil && *limits.Max <= *imported.Max
+}
+
+// hostError is the error of a host function, which traps.
+type hostError struct {
+	name importName
+	err  error
+}
+
+func (e *hostError) Error() string {
+	return fmt.Sprintf("host function [%s] failed: %v", e.name, e.err)
+}
+
+func (e *hostError) Unwrap() error { return e.err }
+
+// callHost calls the host function h with the arguments on top of the stack, which are
+// replaced by its results. Panics of h are recovered as errors.
+func (vm *VM) callHost(h *hostFunction) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &hostError{h.name, &panicError{r}}
+		}
+	}()
+
+	t := h.fn.Type()
+	parameters := h.functionType.ParameterTypes
+	height := len(vm.stack) - len(parameters)
+
+	var args []reflect.Value
+	if h.context {
+		args = append(args, reflect.ValueOf(&vm.ctx).Elem())
+	}
+	if h.memory {
+		var memory Memory
+		if len(vm.instance.memories) > 0 {
+			memory.instance = vm.instance.memories[0]
+		}
+		args = append(args, reflect.ValueOf(memory))
+	}
+
+	for i, value := range vm.stack[height:] {
+		arg, err := vm.hostArgument(t.In(len(args)), parameters[i], value)
+		if err != nil {
+			return &hostError{h.name, fmt.Errorf("argument [%d]: %w", i, err)}
+		}
+		args = append(args, arg)
+	}
+	vm.stack = vm.stack[:height]
+
+	results := h.fn.Call(args)
+	if h.err {
+		if err, _ := results[len(results)-1].Interface().(error); err != nil {
+			return &hostError{h.name, err}
+		}
+	}
+
+	for i, valueType := range h.functionType.ResultTypes {
+		vm.push(vm.hostResult(valueType, results[i]))
+	}
+	return nil
+}
+
+// hostArgument converts value to the Go type t of a host function parameter.
+func (vm *VM) hostArgument(t reflect.Type, valueType ValueType, value uint64) (reflect.Value, error) {
+	arg := reflect.New(t).Elem()
+
+	switch valueType {
+	case ValueTypeI32:
+		if arg.CanInt() {
+			arg.SetInt(int64(int32(uint32(value))))
+		} else {
+			arg.SetUint(uint64(uint32(value)))
+		}
+	case ValueTypeI64:
+		if arg.CanInt() {
+			arg.SetInt(int64(value))
+		} else {
+			arg.SetUint(value)
+		}
+	case ValueTypeF32:
+		arg.SetFloat(float64(math.Float32frombits(uint32(value))))
+	case ValueTypeF64:
+		arg.SetFloat(math.Float64frombits(value))
+	default:
+		v := vm.store.fromValue(valueType, value)
+		if v == nil {
+			break
+		}
+		if !reflect.TypeOf(v).AssignableTo(t) {
+			return arg, fmt.Errorf("%w: expected %s, got %T", ErrTypeMismatch, t, v)
+		}
+		arg.Set(reflect.ValueOf(v))
+	}
+
+	return arg, nil
+}
+
+// hostResult converts the result of a host function to a value of type valueType.
+func (vm *VM) hostResult(valueType ValueType, result reflect.Value) uint64 {
+	switch valueType {
+	case ValueTypeI32:
+		if result.CanInt() {
+			return uint64(uint32(result.Int()))
+		}
+		return uint64(uint32(result.Uint()))
+	case ValueTypeI64:
+		if result.CanInt() {
+			return uint64(result.Int())
+		}
+		return result.Uint()
+	case ValueTypeF32:
+		return uint64(math.Float32bits(float32(result.Float())))
+	case ValueTypeF64:
+		return math.Float64bits(result.Float())
+	default:
+		value, _ := vm.store.toValue(valueType, result.Interface())
+		return value
+	}
+}
@@ -0,0 +1,234 @@
+package jwasm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallingHostFunctions(t *testing.T) {
+	type key struct{}
+	var logged []string
+
+	linker := &Linker{}
+	err := linker.DefineFunc("env", "log", func(ctx context.Context, m Memory, ptr, n uint32) error {
+		b, err := m.Read(ptr, n)
+		if err != nil {
+			return err
+		}
+		logged = append(logged, ctx.Value(key{}).(string)+string(b))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = linker.DefineFunc("env", "add", func(a int32, b int64, c float32) (float64, uint32) {
+		return float64(a) + float64(b) + float64(c), uint32(0xFFFFFFFF)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	module := assemble(t, `(module
+  (import "env" "log" (func $log (param i32 i32)))
+  (import "env" "add" (func $add (param i32 i64 f32) (result f64 i32)))
+  (memory (data "hello"))
+  (func (export "hello") (call $log (i32.const 0) (i32.const 5)))
+  (func (export "overflow") (call $log (i32.const 4) (i32.const 65536)))
+  (func (export "add") (result f64 i32)
+    (call $add (i32.const -1) (i64.const 2) (f32.const 0.5))))`)
+
+	inst, err := linker.Instantiate(context.Background(), NewStore(), module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = inst.CallContext(context.WithValue(context.Background(), key{}, "ctx: "), "hello")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"ctx: hello"}, logged)
+	}
+
+	_, err = inst.Call("overflow")
+	assert.ErrorIs(t, err, ErrOutOfBoundsMemoryAccess)
+
+	results, err := inst.Call("add")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{1.5, int32(-1)}, results)
+	}
+}
+
+func TestLinkingChecksImports(t *testing.T) {
+	linker := &Linker{}
+	if err := linker.DefineFunc("env", "f", func(int32) {}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := linker.Instantiate(context.Background(), NewStore(), assemble(t, `(module
+  (import "env" "f" (func (param i32)))
+  (import "env" "g" (func))
+  (import "other" "h" (func)))`))
+	assert.ErrorIs(t, err, ErrUnknownImport)
+	assert.ErrorContains(t, err, "env.g, other.h")
+
+	_, err = linker.Instantiate(context.Background(), NewStore(), assemble(t, `(import "env" "f" (func (param i64)))`))
+	assert.ErrorIs(t, err, ErrIncompatibleImportType)
+
+	assert.Error(t, linker.DefineFunc("env", "s", func(string) {}))
+	assert.Error(t, linker.DefineFunc("env", "n", 42))
+}
+
+func TestHostFunctionErrorsTrap(t *testing.T) {
+	failure := errors.New("failure")
+
+	linker := &Linker{}
+	if err := linker.DefineFunc("env", "fail", func() error { return failure }); err != nil {
+		t.Fatal(err)
+	}
+
+	inst, err := linker.Instantiate(context.Background(), NewStore(), assemble(t, `(module
+  (import "env" "fail" (func $fail))
+  (func (export "f") (call $fail)))`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = inst.Call("f")
+	assert.ErrorIs(t, err, failure)
+
+	var trap *Trap
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapHostFunction, trap.Code)
+	}
+	assert.Empty(t, inst.store.vm.stack)
+}
+
+func TestHostFunctionErrorsWrappingTrapsAreHostFunctionTraps(t *testing.T) {
+	linker := &Linker{}
+	err := linker.DefineFunc("env", "read", func(memory Memory) error {
+		_, err := memory.Read(100000, 10)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inst, err := linker.Instantiate(context.Background(), NewStore(), assemble(t, `(module
+  (import "env" "read" (func $read))
+  (memory 1)
+  (func (export "f") (call $read)))`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = inst.Call("f")
+	assert.ErrorIs(t, err, ErrOutOfBoundsMemoryAccess)
+
+	var trap *Trap
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapHostFunction, trap.Code)
+	}
+}
+
+func TestLinkingInstances(t *testing.T) {
+	store := NewStore()
+	linker := &Linker{}
+
+	counter, err := linker.Instantiate(context.Background(), store, assemble(t, `(module
+  (global $n (export "n") (mut i32) (i32.const 0))
+  (func (export "next") (result i32)
+    (global.set $n (i32.add (global.get $n) (i32.const 1)))
+    (global.get $n)))`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	linker.DefineInstance("counter", counter)
+
+	module := assemble(t, `(module
+  (import "counter" "next" (func $next (result i32)))
+  (import "counter" "n" (global (mut i32)))
+  (func (export "twice") (result i32) (drop (call $next)) (call $next)))`)
+
+	inst, err := linker.Instantiate(context.Background(), store, module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := inst.Call("twice")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{int32(2)}, results)
+	}
+
+	n, err := counter.Global("n")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(2), n)
+	}
+
+	_, err = linker.Instantiate(context.Background(), NewStore(), module)
+	assert.ErrorContains(t, err, "another store")
+}
+
+func TestHostFunctionPanicsTrap(t *testing.T) {
+	linker := &Linker{}
+	if err := linker.DefineFunc("env", "panic", func(n int32) int32 { return 1 / n }); err != nil {
+		t.Fatal(err)
+	}
+
+	inst, err := linker.Instantiate(context.Background(), NewStore(), assemble(t, `(module
+  (import "env" "panic" (func $panic (param i32) (result i32)))
+  (func (export "f") (param i32) (result i32) (call $panic (local.get 0))))`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = inst.Call("f", int32(0))
+	var trap *Trap
+	if assert.ErrorAs(t, err, &trap) {
+		assert.Equal(t, TrapPanic, trap.Code)
+		assert.Equal(t, []TrapFrame{{FunctionIndex: 1}}, trap.Backtrace)
+		assert.ErrorContains(t, err, "host function [env.panic] failed: panic: runtime error: integer divide by zero")
+	}
+	assert.Empty(t, inst.store.vm.stack)
+
+	results, err := inst.Call("f", int32(1))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{int32(1)}, results)
+	}
+}
+
+func TestReentrantCallsRestoreTheContext(t *testing.T) {
+	type key struct{}
+	var seen []string
+
+	linker := &Linker{}
+	var inst *Instance
+	err := linker.DefineFunc("env", "see", func(ctx context.Context) {
+		seen = append(seen, ctx.Value(key{}).(string))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = linker.DefineFunc("env", "reenter", func() error {
+		_, err := inst.CallContext(context.WithValue(context.Background(), key{}, "inner"), "see")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inst, err = linker.Instantiate(context.Background(), NewStore(), assemble(t, `(module
+  (import "env" "see" (func $see))
+  (import "env" "reenter" (func $reenter))
+  (export "see" (func $see))
+  (func (export "f") (call $see) (call $reenter) (call $see)))`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = inst.CallContext(context.WithValue(context.Background(), key{}, "outer"), "f")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"outer", "inner", "outer"}, seen)
+	}
+}
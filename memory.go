@@ -0,0 +1,212 @@
+package jwasm
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// pageSize is the size in bytes of a page of linear memory.
+// https://webassembly.github.io/spec/core/exec/runtime.html#page-size
+const pageSize = 65536
+
+// memoryInstance is a linear memory, a vector of bytes whose size is a multiple of the
+// page size.
+// https://webassembly.github.io/spec/core/exec/runtime.html#memory-instances
+type memoryInstance struct {
+	memoryType MemoryType
+	data       []byte
+}
+
+func newMemoryInstance(memoryType MemoryType) *memoryInstance {
+	return &memoryInstance{memoryType, make([]byte, uint64(memoryType.Limits.Min)*pageSize)}
+}
+
+// maxMemorySize limits the number of pages of a memory to 1 GiB, which the limits of
+// memory types allow up to maxMemoryPages of.
+const maxMemorySize = 16384
+
+// pages returns the current size of the memory in pages.
+func (m *memoryInstance) pages() uint32 {
+	return uint32(len(m.data) / pageSize)
+}
+
+// grow adds n pages to the memory and returns its previous size. It fails if the memory
+// would exceed its maximum or maxMemorySize.
+// https://webassembly.github.io/spec/core/exec/modules.html#grow-mem
+func (m *memoryInstance) grow(n uint32) (uint32, bool) {
+	previous := m.pages()
+
+	max := uint64(maxMemorySize)
+	if m.memoryType.Limits.Max != nil && uint64(*m.memoryType.Limits.Max) < max {
+		max = uint64(*m.memoryType.Limits.Max)
+	}
+
+	if uint64(previous)+uint64(n) > max {
+		return previous, false
+	}
+
+	// The bytes beyond the length of the memory were never written, they are still zero
+	size := len(m.data) + int(n)*pageSize
+	m.data = slices.Grow(m.data, int(n)*pageSize)[:size]
+	return previous, true
+}
+
+// bytes returns the n bytes at address a, or ErrOutOfBoundsMemoryAccess if they are not
+// all inside of the memory.
+func (m *memoryInstance) bytes(a uint64, n uint64) ([]byte, error) {
+	if a+n > uint64(len(m.data)) {
+		return nil, ErrOutOfBoundsMemoryAccess
+	}
+	return m.data[a : a+n], nil
+}
+
+// Memory Instructions
+// https://webassembly.github.io/spec/core/exec/instructions.html#memory-instructions
+
+// access executes the load or store op with the memory argument ma. Loads of narrower
+// integers are sign or zero extended, stores of them wrap the operand.
+func (vm *VM) access(op opcode, ma memoryArgument) error {
+	var value uint64
+	store := op >= 0x36
+	if store {
+		value = vm.pop()
+	}
+
+	// The effective address is computed without wrapping around
+	a := uint64(uint32(vm.pop())) + uint64(ma.offset)
+	b, err := vm.instance.memories[0].bytes(a, 1<<naturalAlignment(op))
+	if err != nil {
+		return err
+	}
+
+	le := binary.LittleEndian
+	switch op {
+	case 0x28, 0x2A: // i32.load and f32.load
+		vm.push(uint64(le.Uint32(b)))
+	case 0x29, 0x2B: // i64.load and f64.load
+		vm.push(le.Uint64(b))
+	case 0x2C: // i32.load8_s
+		vm.push(uint64(uint32(int32(int8(b[0])))))
+	case 0x2D, 0x31: // i32.load8_u and i64.load8_u
+		vm.push(uint64(b[0]))
+	case 0x2E: // i32.load16_s
+		vm.push(uint64(uint32(int32(int16(le.Uint16(b))))))
+	case 0x2F, 0x33: // i32.load16_u and i64.load16_u
+		vm.push(uint64(le.Uint16(b)))
+	case 0x30: // i64.load8_s
+		vm.push(uint64(int64(int8(b[0]))))
+	case 0x32: // i64.load16_s
+		vm.push(uint64(int64(int16(le.Uint16(b)))))
+	case 0x34: // i64.load32_s
+		vm.push(uint64(int64(int32(le.Uint32(b)))))
+	case 0x35: // i64.load32_u
+		vm.push(uint64(le.Uint32(b)))
+	case 0x36, 0x38, 0x3E: // i32.store, f32.store and i64.store32
+		le.PutUint32(b, uint32(value))
+	case 0x37, 0x39: // i64.store and f64.store
+		le.PutUint64(b, value)
+	case 0x3A, 0x3C: // i32.store8 and i64.store8
+		b[0] = byte(value)
+	default: // i32.store16 and i64.store16
+		le.PutUint16(b, uint16(value))
+	}
+
+	return nil
+}
+
+// memoryGrow executes memory.grow, which pushes -1 if the memory cannot grow.
+func (vm *VM) memoryGrow() {
+	top := len(vm.stack) - 1
+	previous, ok := vm.instance.memories[0].grow(uint32(vm.stack[top]))
+	if !ok {
+		previous = 0xFFFFFFFF
+	}
+	vm.stack[top] = uint64(previous)
+}
+
+// memoryFill executes memory.fill, which sets n bytes at d to the value val.
+func (vm *VM) memoryFill() error {
+	n, val, d := uint64(uint32(vm.pop())), byte(vm.pop()), uint64(uint32(vm.pop()))
+
+	b, err := vm.instance.memories[0].bytes(d, n)
+	if err != nil {
+		return err
+	}
+
+	for i := range b {
+		b[i] = val
+	}
+	return nil
+}
+
+// memoryCopy executes memory.copy, which copies n bytes from s to d. The regions may overlap.
+func (vm *VM) memoryCopy() error {
+	n, s, d := uint64(uint32(vm.pop())), uint64(uint32(vm.pop())), uint64(uint32(vm.pop()))
+
+	src, err := vm.instance.memories[0].bytes(s, n)
+	if err != nil {
+		return err
+	}
+
+	dst, err := vm.instance.memories[0].bytes(d, n)
+	if err != nil {
+		return err
+	}
+
+	copy(dst, src)
+	return nil
+}
+
+// memoryInit executes memory.init, which copies n bytes at s of the data segment x to d.
+// Dropped segments are empty.
+func (vm *VM) memoryInit(x dataIndex) error {
+	n, s, d := uint64(uint32(vm.pop())), uint64(uint32(vm.pop())), uint64(uint32(vm.pop()))
+
+	data := vm.instance.datas[x]
+	if s+n > uint64(len(data)) {
+		return ErrOutOfBoundsMemoryAccess
+	}
+
+	dst, err := vm.instance.memories[0].bytes(d, n)
+	if err != nil {
+		return err
+	}
+
+	copy(dst, data[s:s+n])
+	return nil
+}
+
+// Memory gives host functions access to the linear memory of the module instance that
+// calls them. The zero Memory has a size of 0.
+type Memory struct {
+	instance *memoryInstance
+}
+
+// Size returns the size of the memory in bytes.
+func (m Memory) Size() uint64 {
+	if m.instance == nil {
+		return 0
+	}
+	return uint64(len(m.instance.data))
+}
+
+// Read returns the n bytes at offset, which share their contents with the memory until
+// it grows. It returns ErrOutOfBoundsMemoryAccess if they are not all inside of the memory.
+func (m Memory) Read(offset uint32, n uint32) ([]byte, error) {
+	if m.instance == nil {
+		return nil, ErrOutOfBoundsMemoryAccess
+	}
+	return m.instance.bytes(uint64(offset), uint64(n))
+}
+
+// Write copies b to offset, it returns ErrOutOfBoundsMemoryAccess if b does not fit into
+// the memory.
+func (m Memory) Write(offset uint32, b []byte) error {
+	dst, err := m.Read(offset, uint32(len(b)))
+	if err != nil {
+		return err
+	}
+
+	copy(dst, b)
+	return nil
+}
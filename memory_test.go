@@ -0,0 +1,56 @@
+package jwasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrowingMemory(t *testing.T) {
+	memory := newMemoryInstance(MemoryType{Limits{1, ptr(uint32(2))}})
+
+	previous, ok := memory.grow(1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), previous)
+	assert.Len(t, memory.data, 2*pageSize)
+
+	_, ok = memory.grow(1)
+	assert.False(t, ok)
+	assert.Equal(t, uint32(2), memory.pages())
+}
+
+func TestGrowingMemoryLimitsSize(t *testing.T) {
+	memory := newMemoryInstance(MemoryType{Limits{0, nil}})
+
+	_, ok := memory.grow(maxMemorySize + 1)
+	assert.False(t, ok)
+	assert.Empty(t, memory.data)
+
+	inst := instantiate(t, `(module (memory 0)
+  (func (export "grow") (param i32) (result i32) (memory.grow (local.get 0))))`)
+
+	results, err := inst.Call("grow", int32(maxMemoryPages))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{int32(-1)}, results)
+	}
+}
+
+func TestInstantiatingLimitsMemorySize(t *testing.T) {
+	_, err := (&Interpreter{}).Instantiate(assemble(t, `(memory 65536)`))
+	assert.ErrorContains(t, err, "allocating memory [0] failed")
+}
+
+func TestAccessingMemoryOutOfBounds(t *testing.T) {
+	memory := newMemoryInstance(MemoryType{Limits{1, nil}})
+
+	b, err := memory.bytes(pageSize-4, 4)
+	if assert.NoError(t, err) {
+		assert.Len(t, b, 4)
+	}
+
+	_, err = memory.bytes(pageSize-3, 4)
+	assert.ErrorIs(t, err, ErrOutOfBoundsMemoryAccess)
+
+	_, err = memory.bytes(0xFFFFFFFF+0xFFFFFFFF, 8)
+	assert.ErrorIs(t, err, ErrOutOfBoundsMemoryAccess)
+}
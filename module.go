@@ -1,9 +1,11 @@
 package jwasm
 
 import (
-	"encoding/binary"
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 )
 
 // Indices
@@ -24,6 +26,39 @@ type labelIndex uint32
 
 type SectionId byte
 
+func (id SectionId) String() string {
+	switch id {
+	case customSectionId:
+		return "custom"
+	case typeSectionId:
+		return "type"
+	case importSectionId:
+		return "import"
+	case functionSectionId:
+		return "function"
+	case tableSectionId:
+		return "table"
+	case memorySectionId:
+		return "memory"
+	case globalSectionId:
+		return "global"
+	case exportSectionId:
+		return "export"
+	case startSectionId:
+		return "start"
+	case elementSectionId:
+		return "element"
+	case codeSectionId:
+		return "code"
+	case dataSectionId:
+		return "data"
+	case dataCountSectionId:
+		return "data count"
+	default:
+		return fmt.Sprintf("unknown (%d)", byte(id))
+	}
+}
+
 const (
 	customSectionId    SectionId = 0
 	typeSectionId      SectionId = 1
@@ -42,9 +77,102 @@ const (
 
 type Section interface {
 	section()
+	id() SectionId
+}
+
+// sectionOrder is the position at which each non-custom section has to appear in a module,
+// the data count section is placed before the code section.
+// https://webassembly.github.io/spec/core/binary/modules.html#binary-module
+var sectionOrder = map[SectionId]int{
+	typeSectionId:      1,
+	importSectionId:    2,
+	functionSectionId:  3,
+	tableSectionId:     4,
+	memorySectionId:    5,
+	globalSectionId:    6,
+	exportSectionId:    7,
+	startSectionId:     8,
+	elementSectionId:   9,
+	dataCountSectionId: 10,
+	codeSectionId:      11,
+	dataSectionId:      12,
+}
+
+// Module
+// https://webassembly.github.io/spec/core/binary/modules.html#binary-module
+
+// Module is the decoded form of a WebAssembly binary. Sections keeps every
+// section in the order it appeared in the binary, the typed fields give direct
+// access to the known sections. Fields of sections that were not present are nil.
+type Module struct {
+	Sections []Section
+
+	TypeSection      *TypeSection
+	ImportSection    *ImportSection
+	FunctionSection  *FunctionSection
+	TableSection     *TableSection
+	MemorySection    *MemorySection
+	GlobalSection    *GlobalSection
+	ExportSection    *ExportSection
+	StartSection     *StartSection
+	ElementSection   *ElementSection
+	DataCountSection *DataCountSection
+	CodeSection      *CodeSection
+	DataSection      *DataSection
+	CustomSections   []*CustomSection
+
+	// raw holds the encoding of decoded sections that differs from their shortest encoding,
+	// see rawSection
+	raw map[Section]rawSection
 }
 
-func parseSection(r io.Reader) (Section, error) {
+// rawSection is the encoding of a decoded section. The encoder writes it again as long as
+// the section is unmodified, so that integers encoded with more bytes than needed, as by
+// linkers that leave room for relocations, survive a round trip.
+type rawSection struct {
+	// sizeWidth is the number of bytes that the size of the section is encoded with
+	sizeWidth int
+	// contents is nil if the contents are the shortest encoding of the section
+	contents []byte
+	// hash is the hash of the shortest encoding of the section as it was decoded, the
+	// section is modified if the hash of its shortest encoding differs
+	hash uint64
+}
+
+func (m *Module) addSection(section Section) {
+	m.Sections = append(m.Sections, section)
+
+	switch s := section.(type) {
+	case *CustomSection:
+		m.CustomSections = append(m.CustomSections, s)
+	case *TypeSection:
+		m.TypeSection = s
+	case *ImportSection:
+		m.ImportSection = s
+	case *FunctionSection:
+		m.FunctionSection = s
+	case *TableSection:
+		m.TableSection = s
+	case *MemorySection:
+		m.MemorySection = s
+	case *GlobalSection:
+		m.GlobalSection = s
+	case *ExportSection:
+		m.ExportSection = s
+	case *StartSection:
+		m.StartSection = s
+	case *ElementSection:
+		m.ElementSection = s
+	case *DataCountSection:
+		m.DataCountSection = s
+	case *CodeSection:
+		m.CodeSection = s
+	case *DataSection:
+		m.DataSection = s
+	}
+}
+
+func parseSection(r io.Reader) (Section, rawSection, error) {
 	// https://webassembly.github.io/spec/core/binary/modules.html#sections
 	// Each section consists of
 	// - a one-byte section id,
@@ -52,40 +180,390 @@ func parseSection(r io.Reader) (Section, error) {
 	// - the actual contents, whose structure is dependent on the section id.
 
 	// Parse section id
-	var sectionId byte
-	err := binary.Read(r, binary.BigEndian, &sectionId)
+	sectionId, err := readByte(r)
 
 	if err != nil {
 		if err == io.EOF {
-			return nil, err
+			return nil, rawSection{}, err
 		} else {
-			return nil, fmt.Errorf("reading section id failed: %w", err)
+			return nil, rawSection{}, newDecodeError(offsetOf(r), fmt.Errorf("reading section id failed: %w", err))
 		}
 	}
 
+	id := SectionId(sectionId)
+
 	// Parse section size
+	sizeStart := offsetOf(r)
 	sectionSize, err := ReadUint32(r)
 	if err != nil {
-		return nil, fmt.Errorf("reading section size failed: %w", err)
+		return nil, rawSection{}, sectionDecodeError(id, newDecodeError(offsetOf(r), fmt.Errorf("reading section size failed: %w", err)))
+	}
+
+	// Sections are decoded from memory, bytes.Reader implements io.ByteReader which the
+	// LEB128 decoding relies on for performance
+	start := offsetOf(r)
+	contents, err := readBytes(r, sectionSize)
+	if err != nil {
+		return nil, rawSection{}, sectionDecodeError(id, newDecodeError(offsetOf(r), fmt.Errorf("reading section contents failed: %w", err)))
+	}
+
+	sectionReader := bytes.NewReader(contents)
+
+	section, err := parseSectionContents(id, sectionReader)
+	if err != nil {
+		// Errors are either located by the section itself relative to its start, or
+		// located at the position the section reader stopped at
+		var decodeError *DecodeError
+		if !errors.As(err, &decodeError) {
+			decodeError = newDecodeError(offsetOf(sectionReader), err)
+		}
+
+		decodeError.Offset += start
+		return nil, rawSection{}, sectionDecodeError(id, decodeError)
 	}
 
-	limitReader := io.LimitReader(r, int64(sectionSize))
+	if sectionReader.Len() != 0 {
+		err := fmt.Errorf("%w, section has [%d] bytes, decoded only [%d] bytes", ErrSectionSizeMismatch, sectionSize, offsetOf(sectionReader))
+		return nil, rawSection{}, sectionDecodeError(id, newDecodeError(start+offsetOf(sectionReader), err))
+	}
 
-	// Parse section
-	switch SectionId(sectionId) {
+	return section, rawSection{sizeWidth: int(start - sizeStart), contents: contents}, nil
+}
+
+func sectionDecodeError(id SectionId, err *DecodeError) *DecodeError {
+	err.SectionId = &id
+	return err
+}
+
+func parseSectionContents(id SectionId, r io.Reader) (Section, error) {
+	switch id {
 	case customSectionId:
-		return parseCustomSection(limitReader)
+		return parseCustomSection(r)
 	case typeSectionId:
-		return parseTypeSection(limitReader)
+		return parseTypeSection(r)
+	case importSectionId:
+		return parseImportSection(r)
 	case functionSectionId:
-		return parseFunctionSection(limitReader)
+		return parseFunctionSection(r)
+	case tableSectionId:
+		return parseTableSection(r)
+	case memorySectionId:
+		return parseMemorySection(r)
+	case globalSectionId:
+		return parseGlobalSection(r)
 	case exportSectionId:
-		return parseExportSection(limitReader)
+		return parseExportSection(r)
+	case startSectionId:
+		return parseStartSection(r)
+	case elementSectionId:
+		return parseElementSection(r)
 	case codeSectionId:
-		return parseCodeSection(limitReader)
+		return parseCodeSection(r)
+	case dataSectionId:
+		return parseDataSection(r)
+	case dataCountSectionId:
+		return parseDataCountSection(r)
 	default:
-		return nil, fmt.Errorf("reading of section with unknown id failed: %d", sectionId)
+		return nil, fmt.Errorf("reading of section failed: %w: %d", ErrUnknownSection, byte(id))
+	}
+}
+
+// Index spaces
+// https://webassembly.github.io/spec/core/syntax/modules.html#indices
+//
+// The index space for functions, tables, memories and globals includes respective
+// imports declared in the same module. The indices of these imports precede the
+// indices of other definitions in the same index space.
+
+func (m *Module) imports() []importEntry {
+	if m.ImportSection == nil {
+		return nil
+	}
+	return m.ImportSection.imports
+}
+
+func (m *Module) importedFunctions() []*importDescriptionFunc {
+	var result []*importDescriptionFunc
+	for _, imp := range m.imports() {
+		if desc, ok := imp.importDescription.(*importDescriptionFunc); ok {
+			result = append(result, desc)
+		}
+	}
+	return result
+}
+
+func (m *Module) importedTables() []*importDescriptionTable {
+	var result []*importDescriptionTable
+	for _, imp := range m.imports() {
+		if desc, ok := imp.importDescription.(*importDescriptionTable); ok {
+			result = append(result, desc)
+		}
+	}
+	return result
+}
+
+func (m *Module) importedMemories() []*importDescriptionMem {
+	var result []*importDescriptionMem
+	for _, imp := range m.imports() {
+		if desc, ok := imp.importDescription.(*importDescriptionMem); ok {
+			result = append(result, desc)
+		}
+	}
+	return result
+}
+
+func (m *Module) importedGlobals() []*importDescriptionGlobal {
+	var result []*importDescriptionGlobal
+	for _, imp := range m.imports() {
+		if desc, ok := imp.importDescription.(*importDescriptionGlobal); ok {
+			result = append(result, desc)
+		}
+	}
+	return result
+}
+
+// functionTypeIndex returns the type index of the function at idx in the function index space.
+func (m *Module) functionTypeIndex(idx functionIndex) (typeIndex, error) {
+	imported := m.importedFunctions()
+	if int(idx) < len(imported) {
+		return imported[idx].typeIndex, nil
+	}
+
+	if m.FunctionSection != nil {
+		i := int(idx) - len(imported)
+		if i < len(m.FunctionSection.typeIndices) {
+			return typeIndex(m.FunctionSection.typeIndices[i]), nil
+		}
+	}
+
+	return 0, fmt.Errorf("function index out of range: %d", idx)
+}
+
+// functionType returns the type of the function at idx in the function index space.
+func (m *Module) functionType(idx functionIndex) (FunctionType, error) {
+	typeIdx, err := m.functionTypeIndex(idx)
+	if err != nil {
+		return FunctionType{}, err
+	}
+
+	if m.TypeSection == nil || int(typeIdx) >= len(m.TypeSection.FunctionTypes) {
+		return FunctionType{}, fmt.Errorf("type index out of range: %d", typeIdx)
+	}
+
+	return m.TypeSection.FunctionTypes[typeIdx], nil
+}
+
+// LocalType returns the type of the local at index local of the function at index function
+// in the function index space. The parameters of the function precede its declared locals.
+func (m *Module) LocalType(function uint32, local uint32) (ValueType, error) {
+	functionType, err := m.functionType(functionIndex(function))
+	if err != nil {
+		return nil, err
+	}
+
+	i := int(function) - len(m.importedFunctions())
+	if i < 0 {
+		return nil, fmt.Errorf("function is imported and has no locals: %d", function)
+	}
+
+	if m.CodeSection == nil || i >= len(m.CodeSection.functionCode) {
+		return nil, fmt.Errorf("function has no code: %d", function)
+	}
+
+	return m.CodeSection.functionCode[i].localType(functionType.ParameterTypes, localIndex(local))
+}
+
+// tableType returns the type of the table at idx in the table index space.
+func (m *Module) tableType(idx tableIndex) (TableType, error) {
+	imported := m.importedTables()
+	if int(idx) < len(imported) {
+		return imported[idx].tableType, nil
+	}
+
+	if m.TableSection != nil {
+		i := int(idx) - len(imported)
+		if i < len(m.TableSection.tables) {
+			return m.TableSection.tables[i], nil
+		}
+	}
+
+	return TableType{}, fmt.Errorf("table index out of range: %d", idx)
+}
+
+// memoryType returns the type of the memory at idx in the memory index space.
+func (m *Module) memoryType(idx memoryIndex) (MemoryType, error) {
+	imported := m.importedMemories()
+	if int(idx) < len(imported) {
+		return imported[idx].memoryType, nil
+	}
+
+	if m.MemorySection != nil {
+		i := int(idx) - len(imported)
+		if i < len(m.MemorySection.memories) {
+			return m.MemorySection.memories[i], nil
+		}
+	}
+
+	return MemoryType{}, fmt.Errorf("memory index out of range: %d", idx)
+}
+
+// globalType returns the type of the global at idx in the global index space.
+func (m *Module) globalType(idx globalIndex) (GlobalType, error) {
+	imported := m.importedGlobals()
+	if int(idx) < len(imported) {
+		return imported[idx].globalType, nil
 	}
+
+	if m.GlobalSection != nil {
+		i := int(idx) - len(imported)
+		if i < len(m.GlobalSection.globals) {
+			return m.GlobalSection.globals[i].globalType, nil
+		}
+	}
+
+	return GlobalType{}, fmt.Errorf("global index out of range: %d", idx)
+}
+
+// Imports and Exports
+
+// ExternalKind is the kind of entity that is imported or exported.
+// https://webassembly.github.io/spec/core/syntax/types.html#external-types
+type ExternalKind byte
+
+const (
+	ExternalKindFunc   ExternalKind = 0x00
+	ExternalKindTable  ExternalKind = 0x01
+	ExternalKindMemory ExternalKind = 0x02
+	ExternalKindGlobal ExternalKind = 0x03
+)
+
+func (k ExternalKind) String() string {
+	switch k {
+	case ExternalKindFunc:
+		return "func"
+	case ExternalKindTable:
+		return "table"
+	case ExternalKindMemory:
+		return "memory"
+	case ExternalKindGlobal:
+		return "global"
+	default:
+		return fmt.Sprintf("unknown (%d)", byte(k))
+	}
+}
+
+// Import describes an entity a module requires to be provided on instantiation.
+// Only the type field matching Kind is set.
+type Import struct {
+	Module string
+	Name   string
+	Kind   ExternalKind
+	// Index is the index of the imported entity in the index space of its kind.
+	Index uint32
+
+	FunctionType *FunctionType
+	TableType    *TableType
+	MemoryType   *MemoryType
+	GlobalType   *GlobalType
+}
+
+// Export describes an entity a module makes available to the host and other modules.
+// Only the type field matching Kind is set.
+type Export struct {
+	Name string
+	Kind ExternalKind
+	// Index is the index of the exported entity in the index space of its kind.
+	Index uint32
+
+	FunctionType *FunctionType
+	TableType    *TableType
+	MemoryType   *MemoryType
+	GlobalType   *GlobalType
+}
+
+// Imports lists the imports of the module in the order they are declared.
+// Function signatures are resolved from the type section.
+func (m *Module) Imports() ([]Import, error) {
+	var result []Import
+	var numFunctions, numTables, numMemories, numGlobals uint32
+
+	for _, imp := range m.imports() {
+		result = append(result, Import{Module: imp.module, Name: imp.name})
+		entry := &result[len(result)-1]
+
+		switch desc := imp.importDescription.(type) {
+		case *importDescriptionFunc:
+			functionType, err := m.functionType(functionIndex(numFunctions))
+			if err != nil {
+				return nil, fmt.Errorf("resolving type of imported function [%s.%s] failed: %w", imp.module, imp.name, err)
+			}
+
+			entry.Kind, entry.Index, entry.FunctionType = ExternalKindFunc, numFunctions, &functionType
+			numFunctions++
+		case *importDescriptionTable:
+			tableType := desc.tableType
+			entry.Kind, entry.Index, entry.TableType = ExternalKindTable, numTables, &tableType
+			numTables++
+		case *importDescriptionMem:
+			memoryType := desc.memoryType
+			entry.Kind, entry.Index, entry.MemoryType = ExternalKindMemory, numMemories, &memoryType
+			numMemories++
+		case *importDescriptionGlobal:
+			globalType := desc.globalType
+			entry.Kind, entry.Index, entry.GlobalType = ExternalKindGlobal, numGlobals, &globalType
+			numGlobals++
+		}
+	}
+
+	return result, nil
+}
+
+// Exports lists the exports of the module in the order they are declared.
+// The types of exported entities are resolved from their index spaces.
+func (m *Module) Exports() ([]Export, error) {
+	if m.ExportSection == nil {
+		return nil, nil
+	}
+
+	var result []Export
+	for _, exp := range m.ExportSection.exports {
+		entry := Export{Name: exp.name}
+
+		switch desc := exp.exportDescription.(type) {
+		case *exportDescriptionFunc:
+			functionType, err := m.functionType(desc.functionIndex)
+			if err != nil {
+				return nil, fmt.Errorf("resolving type of exported function [%s] failed: %w", exp.name, err)
+			}
+
+			entry.Kind, entry.Index, entry.FunctionType = ExternalKindFunc, uint32(desc.functionIndex), &functionType
+		case *exportDescriptionTable:
+			tableType, err := m.tableType(desc.tableIndex)
+			if err != nil {
+				return nil, fmt.Errorf("resolving type of exported table [%s] failed: %w", exp.name, err)
+			}
+
+			entry.Kind, entry.Index, entry.TableType = ExternalKindTable, uint32(desc.tableIndex), &tableType
+		case *exportDescriptionMem:
+			memoryType, err := m.memoryType(desc.memoryIndex)
+			if err != nil {
+				return nil, fmt.Errorf("resolving type of exported memory [%s] failed: %w", exp.name, err)
+			}
+
+			entry.Kind, entry.Index, entry.MemoryType = ExternalKindMemory, uint32(desc.memoryIndex), &memoryType
+		case *exportDescriptionGlobal:
+			globalType, err := m.globalType(desc.globalIndex)
+			if err != nil {
+				return nil, fmt.Errorf("resolving type of exported global [%s] failed: %w", exp.name, err)
+			}
+
+			entry.Kind, entry.Index, entry.GlobalType = ExternalKindGlobal, uint32(desc.globalIndex), &globalType
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, nil
 }
 
 // Custom Section
@@ -95,7 +573,8 @@ type CustomSection struct {
 	Data []byte
 }
 
-func (cs *CustomSection) section() {}
+func (cs *CustomSection) section()      {}
+func (cs *CustomSection) id() SectionId { return customSectionId }
 
 func parseCustomSection(r io.Reader) (*CustomSection, error) {
 	// https://webassembly.github.io/spec/core/binary/modules.html#custom-section
@@ -116,7 +595,7 @@ func parseCustomSection(r io.Reader) (*CustomSection, error) {
 		return nil, fmt.Errorf("reading custom section data failed: %w", err)
 	}
 
-	// TOOD: Parse well-known custom sections, see
+	// Well-known custom sections are decoded on demand, see Module.Names and
 	// https://webassembly.github.io/spec/core/appendix/custom.html
 
 	return &CustomSection{sectionName, data}, nil
@@ -155,7 +634,125 @@ func parseTypeSection(r io.Reader) (*TypeSection, error) {
 	return result, nil
 }
 
-func (cs *TypeSection) section() {}
+func (cs *TypeSection) section()      {}
+func (cs *TypeSection) id() SectionId { return typeSectionId }
+
+// Import Section
+
+type ImportSection struct {
+	imports []importEntry
+}
+
+func (cs *ImportSection) section()      {}
+func (cs *ImportSection) id() SectionId { return importSectionId }
+
+// https://webassembly.github.io/spec/core/syntax/modules.html#imports
+type importEntry struct {
+	module            string
+	name              string
+	importDescription importDescription
+}
+
+// https://webassembly.github.io/spec/core/syntax/modules.html#syntax-importdesc
+type importDescription interface {
+	importDescription()
+}
+
+type importDescriptionFunc struct {
+	typeIndex typeIndex
+}
+
+type importDescriptionTable struct {
+	tableType TableType
+}
+
+type importDescriptionMem struct {
+	memoryType MemoryType
+}
+
+type importDescriptionGlobal struct {
+	globalType GlobalType
+}
+
+func (*importDescriptionFunc) importDescription()   {}
+func (*importDescriptionTable) importDescription()  {}
+func (*importDescriptionMem) importDescription()    {}
+func (*importDescriptionGlobal) importDescription() {}
+
+func (importDescriptionFunc) String() string   { return "func" }
+func (importDescriptionTable) String() string  { return "table" }
+func (importDescriptionMem) String() string    { return "mem" }
+func (importDescriptionGlobal) String() string { return "global" }
+
+func parseImportSection(r io.Reader) (*ImportSection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#import-section
+	//
+	// The import section has the id 2. It decodes into a vector of imports that represent
+	// the `imports` component of a module.
+
+	numImports, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of import section failed: %w", err)
+	}
+
+	var imports []importEntry
+	for i := 0; i < int(numImports); i++ {
+		moduleName, err := parseName(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing module name of import failed: %w", err)
+		}
+
+		name, err := parseName(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing name of import failed: %w", err)
+		}
+
+		importDescription, err := parseImportDescription(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing description of import [%s.%s] failed: %w", moduleName, name, err)
+		}
+
+		imports = append(imports, importEntry{moduleName, name, importDescription})
+	}
+
+	return &ImportSection{imports}, nil
+}
+
+func parseImportDescription(r io.Reader) (importDescription, error) {
+	b, err := readByte(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading import description type byte failed: %w", err)
+	}
+
+	switch b {
+	case 0x00:
+		x, err := ReadUint32(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading import description type index failed: %w", err)
+		}
+		return &importDescriptionFunc{typeIndex(x)}, nil
+	case 0x01:
+		tableType, err := parseTableType(r)
+		if err != nil {
+			return nil, err
+		}
+		return &importDescriptionTable{tableType}, nil
+	case 0x02:
+		memoryType, err := parseMemoryType(r)
+		if err != nil {
+			return nil, err
+		}
+		return &importDescriptionMem{memoryType}, nil
+	case 0x03:
+		globalType, err := parseGlobalType(r)
+		if err != nil {
+			return nil, err
+		}
+		return &importDescriptionGlobal{globalType}, nil
+	default:
+		return nil, fmt.Errorf("malformed import kind [0x%x]", b)
+	}
+}
 
 // Function Section
 
@@ -163,7 +760,8 @@ type FunctionSection struct {
 	typeIndices []uint32
 }
 
-func (cs *FunctionSection) section() {}
+func (cs *FunctionSection) section()      {}
+func (cs *FunctionSection) id() SectionId { return functionSectionId }
 
 func parseFunctionSection(r io.Reader) (*FunctionSection, error) {
 	// https://webassembly.github.io/spec/core/binary/modules.html#function-section
@@ -190,13 +788,124 @@ func parseFunctionSection(r io.Reader) (*FunctionSection, error) {
 	return &FunctionSection{typeIndices}, nil
 }
 
+// Table Section
+
+type TableSection struct {
+	tables []TableType
+}
+
+func (cs *TableSection) section()      {}
+func (cs *TableSection) id() SectionId { return tableSectionId }
+
+func parseTableSection(r io.Reader) (*TableSection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#table-section
+	//
+	// The table section has the id 4. It decodes into a vector of tables that represent
+	// the `tables` component of a module.
+
+	numTables, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of table section failed: %w", err)
+	}
+
+	var tables []TableType
+	for i := 0; i < int(numTables); i++ {
+		tableType, err := parseTableType(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing table type in table section failed: %w", err)
+		}
+
+		tables = append(tables, tableType)
+	}
+
+	return &TableSection{tables}, nil
+}
+
+// Memory Section
+
+type MemorySection struct {
+	memories []MemoryType
+}
+
+func (cs *MemorySection) section()      {}
+func (cs *MemorySection) id() SectionId { return memorySectionId }
+
+func parseMemorySection(r io.Reader) (*MemorySection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#memory-section
+	//
+	// The memory section has the id 5. It decodes into a vector of memories that represent
+	// the `mems` component of a module.
+
+	numMemories, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of memory section failed: %w", err)
+	}
+
+	var memories []MemoryType
+	for i := 0; i < int(numMemories); i++ {
+		memoryType, err := parseMemoryType(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing memory type in memory section failed: %w", err)
+		}
+
+		memories = append(memories, memoryType)
+	}
+
+	return &MemorySection{memories}, nil
+}
+
+// Global Section
+
+type GlobalSection struct {
+	globals []global
+}
+
+func (cs *GlobalSection) section()      {}
+func (cs *GlobalSection) id() SectionId { return globalSectionId }
+
+// https://webassembly.github.io/spec/core/syntax/modules.html#globals
+type global struct {
+	globalType GlobalType
+	init       []instruction
+}
+
+func parseGlobalSection(r io.Reader) (*GlobalSection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#global-section
+	//
+	// The global section has the id 6. It decodes into a vector of globals that represent
+	// the `globals` component of a module.
+
+	numGlobals, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of global section failed: %w", err)
+	}
+
+	var globals []global
+	for i := 0; i < int(numGlobals); i++ {
+		globalType, err := parseGlobalType(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing global type in global section failed: %w", err)
+		}
+
+		init, err := parseConstantExpression(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing global initializer failed: %w", err)
+		}
+
+		globals = append(globals, global{globalType, init})
+	}
+
+	return &GlobalSection{globals}, nil
+}
+
 // Export Section
 
 type ExportSection struct {
 	exports []export
 }
 
-func (cs *ExportSection) section() {}
+func (cs *ExportSection) section()      {}
+func (cs *ExportSection) id() SectionId { return exportSectionId }
 
 // https://webassembly.github.io/spec/core/syntax/modules.html#syntax-exportdesc
 type export struct {
@@ -253,14 +962,12 @@ func parseExportSection(r io.Reader) (*ExportSection, error) {
 			return nil, fmt.Errorf("parsing name of export failed: %w", err)
 		}
 
-		var b byte
-		err = binary.Read(r, binary.BigEndian, &b)
+		b, err := readByte(r)
 		if err != nil {
 			return nil, fmt.Errorf("reading export description type byte failed: %w", err)
 		}
 
-		var x uint32
-		err = binary.Read(r, binary.BigEndian, &b)
+		x, err := ReadUint32(r)
 		if err != nil {
 			return nil, fmt.Errorf("reading export description index failed: %w", err)
 		}
@@ -275,6 +982,8 @@ func parseExportSection(r io.Reader) (*ExportSection, error) {
 			exportDescription = &exportDescriptionMem{memoryIndex(x)}
 		case 0x03:
 			exportDescription = &exportDescriptionGlobal{globalIndex(x)}
+		default:
+			return nil, fmt.Errorf("reading export description failed, unknown type [0x%x]", b)
 		}
 
 		export := export{name, exportDescription}
@@ -285,20 +994,234 @@ func parseExportSection(r io.Reader) (*ExportSection, error) {
 	return &ExportSection{exports}, nil
 }
 
+// Start Section
+
+type StartSection struct {
+	start functionIndex
+}
+
+func (cs *StartSection) section()      {}
+func (cs *StartSection) id() SectionId { return startSectionId }
+
+func parseStartSection(r io.Reader) (*StartSection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#start-section
+	//
+	// The start section has the id 8. It decodes into an optional start function that
+	// represents the `start` component of a module.
+
+	x, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading function index of start section failed: %w", err)
+	}
+
+	return &StartSection{functionIndex(x)}, nil
+}
+
+// Element Section
+
+type ElementSection struct {
+	elements []element
+}
+
+func (cs *ElementSection) section()      {}
+func (cs *ElementSection) id() SectionId { return elementSectionId }
+
+// https://webassembly.github.io/spec/core/syntax/modules.html#element-segments
+type element struct {
+	elementType *referenceType
+	// functionIndices holds the initial values for segments that are encoded as a
+	// vector of function indices, init holds them for segments encoded as expressions.
+	// The field that is not used by the encoding of the segment is nil.
+	functionIndices []functionIndex
+	init            [][]instruction
+	mode            elementMode
+}
+
+// https://webassembly.github.io/spec/core/syntax/modules.html#syntax-elemmode
+type elementMode interface {
+	elementMode()
+}
+
+type elementModePassive struct{}
+
+type elementModeActive struct {
+	table  tableIndex
+	offset []instruction
+}
+
+type elementModeDeclarative struct{}
+
+func (*elementModePassive) elementMode()     {}
+func (*elementModeActive) elementMode()      {}
+func (*elementModeDeclarative) elementMode() {}
+
+func parseElementSection(r io.Reader) (*ElementSection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#element-section
+	//
+	// The element section has the id 9. It decodes into a vector of element segments that
+	// represent the `elems` component of a module.
+
+	numElements, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of element section failed: %w", err)
+	}
+
+	var elements []element
+	for i := 0; i < int(numElements); i++ {
+		element, err := parseElement(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing element segment [%d] failed: %w", i, err)
+		}
+
+		elements = append(elements, element)
+	}
+
+	return &ElementSection{elements}, nil
+}
+
+func parseElement(r io.Reader) (element, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#element-section
+	//
+	// The initial integer can be interpreted as a bitfield. Bit 0 indicates a passive
+	// or declarative segment, bit 1 indicates the presence of an explicit table index
+	// for an active segment and otherwise distinguishes passive from declarative
+	// segments, bit 2 indicates the use of element type and element expressions
+	// instead of element kind and element indices.
+
+	flags, err := ReadUint32(r)
+	if err != nil {
+		return element{}, fmt.Errorf("reading element segment flags failed: %w", err)
+	}
+
+	if flags > 7 {
+		return element{}, fmt.Errorf("element segment flags wrong, expected [0..7], got [%d]", flags)
+	}
+
+	isPassiveOrDeclarative := flags&0b001 != 0
+	hasTableIndex := flags&0b010 != 0
+	usesExpressions := flags&0b100 != 0
+
+	var result element
+
+	if isPassiveOrDeclarative {
+		if hasTableIndex {
+			result.mode = &elementModeDeclarative{}
+		} else {
+			result.mode = &elementModePassive{}
+		}
+	} else {
+		var table uint32
+		if hasTableIndex {
+			table, err = ReadUint32(r)
+			if err != nil {
+				return element{}, fmt.Errorf("reading table index of element segment failed: %w", err)
+			}
+		}
+
+		offset, err := parseConstantExpression(r)
+		if err != nil {
+			return element{}, fmt.Errorf("parsing offset of element segment failed: %w", err)
+		}
+
+		result.mode = &elementModeActive{tableIndex(table), offset}
+	}
+
+	// Segments without an explicit element type or kind always contain function references
+	result.elementType = ValueTypeFuncRef
+
+	if isPassiveOrDeclarative || hasTableIndex {
+		if usesExpressions {
+			result.elementType, err = parseReferenceType(r)
+			if err != nil {
+				return element{}, fmt.Errorf("parsing element type of element segment failed: %w", err)
+			}
+		} else {
+			elementKind, err := readByte(r)
+			if err != nil {
+				return element{}, fmt.Errorf("reading element kind of element segment failed: %w", err)
+			}
+
+			if elementKind != 0x00 {
+				return element{}, fmt.Errorf("element kind wrong, expected [0x00], got [0x%x]", elementKind)
+			}
+		}
+	}
+
+	numInit, err := ReadUint32(r)
+	if err != nil {
+		return element{}, fmt.Errorf("reading vector size of element segment failed: %w", err)
+	}
+
+	// Keep track of the encoding even for empty segments
+	if usesExpressions {
+		result.init = [][]instruction{}
+	} else {
+		result.functionIndices = []functionIndex{}
+	}
+
+	for i := 0; i < int(numInit); i++ {
+		if usesExpressions {
+			expression, err := parseConstantExpression(r)
+			if err != nil {
+				return element{}, fmt.Errorf("parsing element expression failed: %w", err)
+			}
+
+			result.init = append(result.init, expression)
+		} else {
+			x, err := ReadUint32(r)
+			if err != nil {
+				return element{}, fmt.Errorf("reading function index of element segment failed: %w", err)
+			}
+
+			result.functionIndices = append(result.functionIndices, functionIndex(x))
+		}
+	}
+
+	return result, nil
+}
+
 // Code Section
 
 type CodeSection struct {
 	functionCode []functionCode
 }
 
-func (cs *CodeSection) section() {}
+func (cs *CodeSection) section()      {}
+func (cs *CodeSection) id() SectionId { return codeSectionId }
 
 // https://webassembly.github.io/spec/core/binary/modules.html#binary-func
 type functionCode struct {
-	locals map[ValueType]uint32
+	locals []locals
 	body   []instruction
 }
 
+// https://webassembly.github.io/spec/core/binary/modules.html#binary-local
+//
+// Local declarations are compressed into runs of n locals of the same value type,
+// kept in the order they were declared.
+type locals struct {
+	n         uint32
+	valueType ValueType
+}
+
+// localType returns the type of the local at idx. The parameters of the function
+// come first in the local index space, followed by the declared locals.
+func (fc *functionCode) localType(parameters ResultType, idx localIndex) (ValueType, error) {
+	if int(idx) < len(parameters) {
+		return parameters[idx], nil
+	}
+
+	offset := uint64(idx) - uint64(len(parameters))
+	for _, run := range fc.locals {
+		if offset < uint64(run.n) {
+			return run.valueType, nil
+		}
+		offset -= uint64(run.n)
+	}
+
+	return nil, fmt.Errorf("local index out of range: %d", idx)
+}
+
 func parseCodeSection(r io.Reader) (*CodeSection, error) {
 	// https://webassembly.github.io/spec/core/binary/modules.html#code-section
 	//
@@ -320,36 +1243,181 @@ func parseCodeSection(r io.Reader) (*CodeSection, error) {
 			return nil, fmt.Errorf("reading vector size of function code failed: %w", err)
 		}
 
-		// Parse locals
-		limitReader := io.LimitReader(r, int64(codeSize))
-		numLocals, err := ReadUint32(limitReader)
+		start := offsetOf(r)
+		code, err := readBytes(r, codeSize)
+		if err != nil {
+			return nil, fmt.Errorf("reading function code failed: %w", err)
+		}
+
+		codeReader := bytes.NewReader(code)
+		functionCode, err := parseFunctionCode(codeReader)
+		if err == nil && codeReader.Len() != 0 {
+			err = fmt.Errorf("%w, function code has [%d] bytes, decoded only [%d] bytes", ErrSectionSizeMismatch, codeSize, offsetOf(codeReader))
+		}
+
 		if err != nil {
-			return nil, fmt.Errorf("reading vector size of function locals failed: %w", err)
+			// The function index is relative to the code section, the parser shifts it by
+			// the number of imported functions
+			decodeError := newDecodeError(start+offsetOf(codeReader), err)
+			index := uint32(i)
+			decodeError.FunctionIndex = &index
+			return nil, decodeError
 		}
 
-		locals := make(map[ValueType]uint32)
-		for j := 0; j < int(numLocals); j++ {
-			n, err := ReadUint32(limitReader)
+		result = append(result, functionCode)
+	}
+
+	return &CodeSection{result}, nil
+}
+
+func parseFunctionCode(r io.Reader) (functionCode, error) {
+	// Parse locals
+	numLocals, err := ReadUint32(r)
+	if err != nil {
+		return functionCode{}, fmt.Errorf("reading vector size of function locals failed: %w", err)
+	}
+
+	var result []locals
+	var total uint64
+	for j := 0; j < int(numLocals); j++ {
+		n, err := ReadUint32(r)
+		if err != nil {
+			return functionCode{}, fmt.Errorf("reading function locals n failed: %w", err)
+		}
+
+		valueType, err := parseValueType(r)
+		if err != nil {
+			return functionCode{}, fmt.Errorf("reading function locals value type failed: %w", err)
+		}
+
+		// The total number of locals must be representable as a local index
+		total += uint64(n)
+		if total > math.MaxUint32 {
+			return functionCode{}, fmt.Errorf("too many locals, got at least [%d]", total)
+		}
+
+		result = append(result, locals{n, valueType})
+	}
+
+	instructions, err := parseInstructions(r)
+	if err != nil {
+		return functionCode{}, fmt.Errorf("reading function body failed: %w", err)
+	}
+
+	return functionCode{result, instructions}, nil
+}
+
+// Data Section
+
+type DataSection struct {
+	data []data
+}
+
+func (cs *DataSection) section()      {}
+func (cs *DataSection) id() SectionId { return dataSectionId }
+
+// https://webassembly.github.io/spec/core/syntax/modules.html#data-segments
+type data struct {
+	init []byte
+	mode dataMode
+}
+
+// https://webassembly.github.io/spec/core/syntax/modules.html#syntax-datamode
+type dataMode interface {
+	dataMode()
+}
+
+type dataModePassive struct{}
+
+type dataModeActive struct {
+	memory memoryIndex
+	offset []instruction
+}
+
+func (*dataModePassive) dataMode() {}
+func (*dataModeActive) dataMode()  {}
+
+func parseDataSection(r io.Reader) (*DataSection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#data-section
+	//
+	// The data section has the id 11. It decodes into a vector of data segments that
+	// represent the `datas` component of a module.
+
+	numData, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of data section failed: %w", err)
+	}
+
+	var result []data
+	for i := 0; i < int(numData); i++ {
+		flags, err := ReadUint32(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading data segment flags failed: %w", err)
+		}
+
+		var mode dataMode
+		switch flags {
+		case 0:
+			offset, err := parseConstantExpression(r)
+			if err != nil {
+				return nil, fmt.Errorf("parsing offset of data segment failed: %w", err)
+			}
+			mode = &dataModeActive{0, offset}
+		case 1:
+			mode = &dataModePassive{}
+		case 2:
+			memory, err := ReadUint32(r)
 			if err != nil {
-				return nil, fmt.Errorf("reading function locals n failed: %w", err)
+				return nil, fmt.Errorf("reading memory index of data segment failed: %w", err)
 			}
 
-			valueType, err := parseValueType(limitReader)
+			offset, err := parseConstantExpression(r)
 			if err != nil {
-				return nil, fmt.Errorf("reading function locals value type failed: %w", err)
+				return nil, fmt.Errorf("parsing offset of data segment failed: %w", err)
 			}
+			mode = &dataModeActive{memoryIndex(memory), offset}
+		default:
+			return nil, fmt.Errorf("data segment flags wrong, expected [0..2], got [%d]", flags)
+		}
 
-			locals[valueType] += n
+		size, err := ReadUint32(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading vector size of data segment failed: %w", err)
 		}
 
-		instructions, err := parseInstructions(limitReader)
+		// The bytes are read before they are allocated, so that an oversized length fails at
+		// the end of the section rather than allocating it
+		init, err := readBytes(r, size)
 		if err != nil {
-			return nil, fmt.Errorf("reading function body failed: %w", err)
+			return nil, fmt.Errorf("reading data segment bytes failed: %w", err)
 		}
 
-		functionCode := functionCode{locals, instructions}
-		result = append(result, functionCode)
+		result = append(result, data{init, mode})
 	}
 
-	return &CodeSection{result}, nil
+	return &DataSection{result}, nil
+}
+
+// Data Count Section
+
+type DataCountSection struct {
+	count uint32
+}
+
+func (cs *DataCountSection) section()      {}
+func (cs *DataCountSection) id() SectionId { return dataCountSectionId }
+
+func parseDataCountSection(r io.Reader) (*DataCountSection, error) {
+	// https://webassembly.github.io/spec/core/binary/modules.html#data-count-section
+	//
+	// The data count section has the id 12. It decodes into an optional u32 that
+	// represents the number of data segments in the data section. If this count does
+	// not match the length of the data segment vector, the module is malformed.
+
+	count, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading data count failed: %w", err)
+	}
+
+	return &DataCountSection{count}, nil
 }
@@ -2,6 +2,8 @@ package jwasm
 
 import (
 	"bytes"
+	"io"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,3 +24,244 @@ func TestParsingCustomSection(t *testing.T) {
 	assert.Equal(t, section.Data, []byte{0x02, 0x01, 0x00}, "section data should be [2, 1, 0]")
 
 }
+
+func TestParsingImportSection(t *testing.T) {
+	data := []byte{
+		0x04,
+		// (import "env" "f" (func (type 1)))
+		0x03, 0x65, 0x6E, 0x76, 0x01, 0x66, 0x00, 0x01,
+		// (import "env" "t" (table 1 10 funcref))
+		0x03, 0x65, 0x6E, 0x76, 0x01, 0x74, 0x01, 0x70, 0x01, 0x01, 0x0A,
+		// (import "env" "m" (memory 2))
+		0x03, 0x65, 0x6E, 0x76, 0x01, 0x6D, 0x02, 0x00, 0x02,
+		// (import "env" "g" (global (mut i64)))
+		0x03, 0x65, 0x6E, 0x76, 0x01, 0x67, 0x03, 0x7E, 0x01,
+	}
+	r := bytes.NewReader(data[:])
+
+	section, err := parseImportSection(r)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, section.imports, 4)
+	assert.Equal(t, "env", section.imports[0].module)
+	assert.Equal(t, "f", section.imports[0].name)
+	assert.Equal(t, &importDescriptionFunc{1}, section.imports[0].importDescription)
+
+	max := uint32(10)
+	assert.Equal(t, Limits{1, &max}, section.imports[1].importDescription.(*importDescriptionTable).tableType.Limits)
+	assert.Equal(t, Limits{2, nil}, section.imports[2].importDescription.(*importDescriptionMem).memoryType.Limits)
+	assert.True(t, section.imports[3].importDescription.(*importDescriptionGlobal).globalType.Mutable)
+
+	module := &Module{ImportSection: section, FunctionSection: &FunctionSection{[]uint32{0}}}
+
+	idx, err := module.functionTypeIndex(0)
+	assert.NoError(t, err)
+	assert.Equal(t, typeIndex(1), idx, "imported functions come first in the index space")
+
+	idx, err = module.functionTypeIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, typeIndex(0), idx)
+
+	_, err = module.functionTypeIndex(2)
+	assert.Error(t, err)
+}
+
+func TestParsingGlobalSection(t *testing.T) {
+	data := []byte{
+		0x04,
+		// (global i32 (i32.const -1))
+		0x7F, 0x00, 0x41, 0x7F, 0x0B,
+		// (global (mut i64) (i64.const 624485))
+		0x7E, 0x01, 0x42, 0xE5, 0x8E, 0x26, 0x0B,
+		// (global f64 (f64.const 1.5))
+		0x7C, 0x00, 0x44, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xF8, 0x3F, 0x0B,
+		// (global funcref (ref.null func))
+		0x70, 0x00, 0xD0, 0x70, 0x0B,
+	}
+	r := bytes.NewReader(data[:])
+
+	section, err := parseGlobalSection(r)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, section.globals, 4)
+	assert.Equal(t, []instruction{&int32Const{-1}}, section.globals[0].init)
+	assert.True(t, section.globals[1].globalType.Mutable)
+	assert.Equal(t, []instruction{&int64Const{624485}}, section.globals[1].init)
+	assert.Equal(t, []instruction{&float64Const{1.5}}, section.globals[2].init)
+	assert.IsType(t, &refNull{}, section.globals[3].init[0])
+}
+
+func TestParsingGlobalSectionLeavesNonConstantInitializerToValidation(t *testing.T) {
+	// (global i32 (local.get 0))
+	data := []byte{0x01, 0x7F, 0x00, 0x20, 0x00, 0x0B}
+
+	section, err := parseGlobalSection(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	module := &Module{}
+	module.addSection(section)
+
+	assert.ErrorIs(t, Validate(module), ErrConstantExpressionRequired)
+}
+
+func TestParsingMemoryAndTableSection(t *testing.T) {
+	memories, err := parseMemorySection(bytes.NewReader([]byte{0x01, 0x01, 0x01, 0x02}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	max := uint32(2)
+	assert.Equal(t, []MemoryType{{Limits{1, &max}}}, memories.memories)
+
+	tables, err := parseTableSection(bytes.NewReader([]byte{0x01, 0x6F, 0x00, 0x03}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, tables.tables, 1)
+	assert.Equal(t, Limits{3, nil}, tables.tables[0].Limits)
+	assert.Same(t, ValueTypeExternRef, tables.tables[0].ElementType)
+}
+
+func TestParsingElementSection(t *testing.T) {
+	data := []byte{
+		0x08,
+		// 0: active, table 0, function indices
+		0x00, 0x41, 0x00, 0x0B, 0x02, 0x00, 0x01,
+		// 1: passive, element kind, function indices
+		0x01, 0x00, 0x01, 0x02,
+		// 2: active, table 1, element kind, function indices
+		0x02, 0x01, 0x41, 0x04, 0x0B, 0x00, 0x01, 0x03,
+		// 3: declarative, element kind, function indices
+		0x03, 0x00, 0x01, 0x04,
+		// 4: active, table 0, expressions
+		0x04, 0x41, 0x00, 0x0B, 0x01, 0xD2, 0x05, 0x0B,
+		// 5: passive, element type, expressions
+		0x05, 0x6F, 0x01, 0xD0, 0x6F, 0x0B,
+		// 6: active, table 2, element type, expressions
+		0x06, 0x02, 0x41, 0x00, 0x0B, 0x70, 0x01, 0xD0, 0x70, 0x0B,
+		// 7: declarative, element type, expressions
+		0x07, 0x70, 0x01, 0xD2, 0x06, 0x0B,
+	}
+	r := bytes.NewReader(data[:])
+
+	section, err := parseElementSection(r)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, section.elements, 8)
+	assert.Equal(t, []functionIndex{0, 1}, section.elements[0].functionIndices)
+	assert.Equal(t, &elementModeActive{0, []instruction{&int32Const{0}}}, section.elements[0].mode)
+	assert.IsType(t, &elementModePassive{}, section.elements[1].mode)
+	assert.Equal(t, &elementModeActive{1, []instruction{&int32Const{4}}}, section.elements[2].mode)
+	assert.IsType(t, &elementModeDeclarative{}, section.elements[3].mode)
+	assert.Equal(t, [][]instruction{{&refFunc{5}}}, section.elements[4].init)
+	assert.Equal(t, "externref", section.elements[5].elementType.String())
+	assert.IsType(t, &elementModePassive{}, section.elements[5].mode)
+	assert.Equal(t, tableIndex(2), section.elements[6].mode.(*elementModeActive).table)
+	assert.IsType(t, &elementModeDeclarative{}, section.elements[7].mode)
+	assert.Equal(t, "funcref", section.elements[7].elementType.String())
+}
+
+func TestParsingDataSection(t *testing.T) {
+	data := []byte{
+		0x03,
+		// 0: active, memory 0
+		0x00, 0x41, 0x08, 0x0B, 0x02, 0x68, 0x69,
+		// 1: passive
+		0x01, 0x01, 0x21,
+		// 2: active, memory 1
+		0x02, 0x01, 0x41, 0x00, 0x0B, 0x00,
+	}
+	r := bytes.NewReader(data[:])
+
+	section, err := parseDataSection(r)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, section.data, 3)
+	assert.Equal(t, []byte("hi"), section.data[0].init)
+	assert.Equal(t, &dataModeActive{0, []instruction{&int32Const{8}}}, section.data[0].mode)
+	assert.IsType(t, &dataModePassive{}, section.data[1].mode)
+	assert.Equal(t, memoryIndex(1), section.data[2].mode.(*dataModeActive).memory)
+	assert.Empty(t, section.data[2].init)
+}
+
+func TestParsingDataSectionRejectsOversizedSegment(t *testing.T) {
+	// A passive segment that declares 0xFFFFFFFF bytes but holds only one
+	data := []byte{0x01, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0x0F, 0x21}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := parseDataSection(bytes.NewReader(data))
+	runtime.ReadMemStats(&after)
+
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+}
+
+func TestParsingCodeSectionPreservesLocals(t *testing.T) {
+	data := []byte{
+		0x01,
+		// (func (local i64 i64) (local f32) (local i64) nop)
+		0x09, 0x03, 0x02, 0x7E, 0x01, 0x7D, 0x01, 0x7E, 0x01, 0x0B,
+	}
+
+	section, err := parseCodeSection(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []locals{{2, ValueTypeI64}, {1, ValueTypeF32}, {1, ValueTypeI64}}, section.functionCode[0].locals)
+
+	module := &Module{
+		TypeSection:     &TypeSection{[]FunctionType{{ResultType{ValueTypeI32}, nil}}},
+		FunctionSection: &FunctionSection{[]uint32{0}},
+		CodeSection:     section,
+	}
+
+	for i, expected := range []ValueType{ValueTypeI32, ValueTypeI64, ValueTypeI64, ValueTypeF32, ValueTypeI64} {
+		actual, err := module.LocalType(0, uint32(i))
+		assert.NoError(t, err)
+		assert.Same(t, expected, actual, "local %d", i)
+	}
+
+	_, err = module.LocalType(0, 5)
+	assert.Error(t, err)
+}
+
+func TestParsingCodeSectionRejectsTooManyLocals(t *testing.T) {
+	data := []byte{
+		0x01,
+		// (func (local i32 * 0xFFFFFFFF) (local i32))
+		0x0A, 0x02, 0xFF, 0xFF, 0xFF, 0xFF, 0x0F, 0x7F, 0x01, 0x7F, 0x0B,
+	}
+
+	_, err := parseCodeSection(bytes.NewReader(data))
+
+	assert.ErrorContains(t, err, "too many locals")
+}
+
+func TestParsingExportSectionDecodesIndexAsLEB128(t *testing.T) {
+	// (export "f" (func 200))
+	data := []byte{0x01, 0x01, 0x66, 0x00, 0xC8, 0x01}
+
+	section, err := parseExportSection(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []export{{"f", &exportDescriptionFunc{200}}}, section.exports)
+}
@@ -0,0 +1,162 @@
+package jwasm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Name Section
+// https://webassembly.github.io/spec/core/appendix/custom.html#name-section
+
+// NameSection holds the debug names of a module. The maps are keyed by indices in the
+// function index space and, for local names, the local index space of each function.
+type NameSection struct {
+	ModuleName    string
+	FunctionNames map[uint32]string
+	LocalNames    map[uint32]map[uint32]string
+}
+
+const (
+	moduleNameSubsectionId   byte = 0
+	functionNameSubsectionId byte = 1
+	localNameSubsectionId    byte = 2
+)
+
+// Names decodes the name section of the module. It returns nil if the module has no
+// name section or if it is malformed, custom sections are not allowed to affect the
+// semantics of a module.
+func (m *Module) Names() *NameSection {
+	for _, section := range m.CustomSections {
+		if section.Name != "name" {
+			continue
+		}
+
+		names, err := parseNameSection(bytes.NewReader(section.Data))
+		if err != nil {
+			return nil
+		}
+
+		return names
+	}
+
+	return nil
+}
+
+// FunctionName returns the name of the function at idx, or an empty string if there is none.
+func (ns *NameSection) FunctionName(idx uint32) string {
+	if ns == nil {
+		return ""
+	}
+	return ns.FunctionNames[idx]
+}
+
+// LocalName returns the name of the local at idx of the given function, or an empty string
+// if there is none.
+func (ns *NameSection) LocalName(function uint32, idx uint32) string {
+	if ns == nil {
+		return ""
+	}
+	return ns.LocalNames[function][idx]
+}
+
+func parseNameSection(r *bytes.Reader) (*NameSection, error) {
+	// https://webassembly.github.io/spec/core/appendix/custom.html#subsections
+	//
+	// The data of a name section consists of a sequence of subsections. Each subsection
+	// consists of a one-byte subsection id, the u32 size of the contents, in bytes, and
+	// the actual contents, whose structure is dependent on the subsection id.
+
+	result := &NameSection{
+		FunctionNames: make(map[uint32]string),
+		LocalNames:    make(map[uint32]map[uint32]string),
+	}
+
+	for r.Len() > 0 {
+		id, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("reading name subsection id failed: %w", err)
+		}
+
+		size, err := ReadUint32(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading name subsection size failed: %w", err)
+		}
+
+		contents, err := readBytes(r, size)
+		if err != nil {
+			return nil, fmt.Errorf("reading name subsection failed: %w", err)
+		}
+
+		subsection := bytes.NewReader(contents)
+		switch id {
+		case moduleNameSubsectionId:
+			result.ModuleName, err = parseName(subsection)
+		case functionNameSubsectionId:
+			result.FunctionNames, err = parseNameMap(subsection)
+		case localNameSubsectionId:
+			result.LocalNames, err = parseIndirectNameMap(subsection)
+		default:
+			// Subsections defined by proposals are skipped
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing name subsection [%d] failed: %w", id, err)
+		}
+	}
+
+	return result, nil
+}
+
+func parseNameMap(r io.Reader) (map[uint32]string, error) {
+	// https://webassembly.github.io/spec/core/appendix/custom.html#name-maps
+
+	size, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of name map failed: %w", err)
+	}
+
+	result := make(map[uint32]string)
+	for i := 0; i < int(size); i++ {
+		idx, err := ReadUint32(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading index of name map failed: %w", err)
+		}
+
+		name, err := parseName(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing name of name map failed: %w", err)
+		}
+
+		result[idx] = name
+	}
+
+	return result, nil
+}
+
+func parseIndirectNameMap(r io.Reader) (map[uint32]map[uint32]string, error) {
+	// https://webassembly.github.io/spec/core/appendix/custom.html#name-maps
+
+	size, err := ReadUint32(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading vector size of indirect name map failed: %w", err)
+	}
+
+	result := make(map[uint32]map[uint32]string)
+	for i := 0; i < int(size); i++ {
+		idx, err := ReadUint32(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading index of indirect name map failed: %w", err)
+		}
+
+		nameMap, err := parseNameMap(r)
+		if err != nil {
+			return nil, err
+		}
+
+		result[idx] = nameMap
+	}
+
+	return result, nil
+}
@@ -0,0 +1,42 @@
+package jwasm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Name section of (module $m (func $add (param $a i32) (param $b i32)))
+var nameSectionTestData = []byte{
+	// Module name
+	0x00, 0x02, 0x01, 0x6D,
+	// Function names
+	0x01, 0x06, 0x01, 0x00, 0x03, 0x61, 0x64, 0x64,
+	// Local names
+	0x02, 0x09, 0x01, 0x00, 0x02, 0x00, 0x01, 0x61, 0x01, 0x01, 0x62,
+	// Unknown subsection
+	0x07, 0x01, 0x00,
+}
+
+func TestParsingNameSection(t *testing.T) {
+	names, err := parseNameSection(bytes.NewReader(nameSectionTestData))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "m", names.ModuleName)
+	assert.Equal(t, "add", names.FunctionName(0))
+	assert.Equal(t, "", names.FunctionName(1))
+	assert.Equal(t, "a", names.LocalName(0, 0))
+	assert.Equal(t, "b", names.LocalName(0, 1))
+	assert.Equal(t, "", names.LocalName(1, 0))
+}
+
+func TestModuleNamesIgnoresMalformedNameSection(t *testing.T) {
+	module := &Module{}
+	module.addSection(&CustomSection{"name", []byte{0x01, 0x05, 0x01}})
+
+	assert.Nil(t, module.Names())
+	assert.Equal(t, "", module.Names().FunctionName(0))
+}
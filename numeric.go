@@ -0,0 +1,464 @@
+package jwasm
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Numeric instructions
+// https://webassembly.github.io/spec/core/exec/numerics.html
+//
+// Operands are the raw bits of values as they are kept on the stack: i32 and f32 values
+// occupy the low 32 bits, i64 and f64 values all 64 bits. Reinterpretations are therefore
+// no-ops.
+
+// int32Compare applies the i32 test or comparison op to a and b.
+func int32Compare(op opcode, a, b uint32) bool {
+	switch op {
+	case 0x45: // i32.eqz
+		return a == 0
+	case 0x46: // i32.eq
+		return a == b
+	case 0x47: // i32.ne
+		return a != b
+	case 0x48: // i32.lt_s
+		return int32(a) < int32(b)
+	case 0x49: // i32.lt_u
+		return a < b
+	case 0x4A: // i32.gt_s
+		return int32(a) > int32(b)
+	case 0x4B: // i32.gt_u
+		return a > b
+	case 0x4C: // i32.le_s
+		return int32(a) <= int32(b)
+	case 0x4D: // i32.le_u
+		return a <= b
+	default: // i32.ge_s and i32.ge_u
+		if op == 0x4E {
+			return int32(a) >= int32(b)
+		}
+		return a >= b
+	}
+}
+
+// int64Compare applies the i64 test or comparison op to a and b.
+func int64Compare(op opcode, a, b uint64) bool {
+	switch op {
+	case 0x50: // i64.eqz
+		return a == 0
+	case 0x51: // i64.eq
+		return a == b
+	case 0x52: // i64.ne
+		return a != b
+	case 0x53: // i64.lt_s
+		return int64(a) < int64(b)
+	case 0x54: // i64.lt_u
+		return a < b
+	case 0x55: // i64.gt_s
+		return int64(a) > int64(b)
+	case 0x56: // i64.gt_u
+		return a > b
+	case 0x57: // i64.le_s
+		return int64(a) <= int64(b)
+	case 0x58: // i64.le_u
+		return a <= b
+	default: // i64.ge_s and i64.ge_u
+		if op == 0x59 {
+			return int64(a) >= int64(b)
+		}
+		return a >= b
+	}
+}
+
+// floatCompare applies the f32 or f64 comparison op to a and b, comparisons with NaN
+// are false except for ne.
+func floatCompare(op opcode, a, b float64) bool {
+	if op >= 0x61 {
+		op -= 0x61 - 0x5B
+	}
+
+	switch op {
+	case 0x5B: // eq
+		return a == b
+	case 0x5C: // ne
+		return a != b
+	case 0x5D: // lt
+		return a < b
+	case 0x5E: // gt
+		return a > b
+	case 0x5F: // le
+		return a <= b
+	default: // ge
+		return a >= b
+	}
+}
+
+// int32Unary applies the i32 unary operator op to a.
+func int32Unary(op opcode, a uint32) uint32 {
+	switch op {
+	case 0x67: // i32.clz
+		return uint32(bits.LeadingZeros32(a))
+	case 0x68: // i32.ctz
+		return uint32(bits.TrailingZeros32(a))
+	case 0x69: // i32.popcnt
+		return uint32(bits.OnesCount32(a))
+	case 0xC0: // i32.extend8_s
+		return uint32(int32(int8(a)))
+	default: // i32.extend16_s
+		return uint32(int32(int16(a)))
+	}
+}
+
+// int64Unary applies the i64 unary operator op to a.
+func int64Unary(op opcode, a uint64) uint64 {
+	switch op {
+	case 0x79: // i64.clz
+		return uint64(bits.LeadingZeros64(a))
+	case 0x7A: // i64.ctz
+		return uint64(bits.TrailingZeros64(a))
+	case 0x7B: // i64.popcnt
+		return uint64(bits.OnesCount64(a))
+	case 0xC2: // i64.extend8_s
+		return uint64(int64(int8(a)))
+	case 0xC3: // i64.extend16_s
+		return uint64(int64(int16(a)))
+	default: // i64.extend32_s
+		return uint64(int64(int32(a)))
+	}
+}
+
+// int32Binary applies the i32 binary operator op to a and b. Division traps if b is zero
+// or if the signed quotient overflows, shift counts are taken modulo 32.
+func int32Binary(op opcode, a, b uint32) (uint32, error) {
+	switch op {
+	case 0x6A: // i32.add
+		return a + b, nil
+	case 0x6B: // i32.sub
+		return a - b, nil
+	case 0x6C: // i32.mul
+		return a * b, nil
+	case 0x6D: // i32.div_s
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		if int32(a) == math.MinInt32 && int32(b) == -1 {
+			return 0, ErrIntegerOverflow
+		}
+		return uint32(int32(a) / int32(b)), nil
+	case 0x6E: // i32.div_u
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return a / b, nil
+	case 0x6F: // i32.rem_s
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		// The remainder of the overflowing division is 0, as in Go
+		return uint32(int32(a) % int32(b)), nil
+	case 0x70: // i32.rem_u
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return a % b, nil
+	case 0x71: // i32.and
+		return a & b, nil
+	case 0x72: // i32.or
+		return a | b, nil
+	case 0x73: // i32.xor
+		return a ^ b, nil
+	case 0x74: // i32.shl
+		return a << (b % 32), nil
+	case 0x75: // i32.shr_s
+		return uint32(int32(a) >> (b % 32)), nil
+	case 0x76: // i32.shr_u
+		return a >> (b % 32), nil
+	case 0x77: // i32.rotl
+		return bits.RotateLeft32(a, int(b%32)), nil
+	default: // i32.rotr
+		return bits.RotateLeft32(a, -int(b%32)), nil
+	}
+}
+
+// int64Binary applies the i64 binary operator op to a and b. Division traps if b is zero
+// or if the signed quotient overflows, shift counts are taken modulo 64.
+func int64Binary(op opcode, a, b uint64) (uint64, error) {
+	switch op {
+	case 0x7C: // i64.add
+		return a + b, nil
+	case 0x7D: // i64.sub
+		return a - b, nil
+	case 0x7E: // i64.mul
+		return a * b, nil
+	case 0x7F: // i64.div_s
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		if int64(a) == math.MinInt64 && int64(b) == -1 {
+			return 0, ErrIntegerOverflow
+		}
+		return uint64(int64(a) / int64(b)), nil
+	case 0x80: // i64.div_u
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return a / b, nil
+	case 0x81: // i64.rem_s
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return uint64(int64(a) % int64(b)), nil
+	case 0x82: // i64.rem_u
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return a % b, nil
+	case 0x83: // i64.and
+		return a & b, nil
+	case 0x84: // i64.or
+		return a | b, nil
+	case 0x85: // i64.xor
+		return a ^ b, nil
+	case 0x86: // i64.shl
+		return a << (b % 64), nil
+	case 0x87: // i64.shr_s
+		return uint64(int64(a) >> (b % 64)), nil
+	case 0x88: // i64.shr_u
+		return a >> (b % 64), nil
+	case 0x89: // i64.rotl
+		return bits.RotateLeft64(a, int(b%64)), nil
+	default: // i64.rotr
+		return bits.RotateLeft64(a, -int(b%64)), nil
+	}
+}
+
+// float32Unary applies the f32 unary operator op to the bits of a. abs and neg only
+// change the sign bit, so that they preserve NaN payloads, the other operators return
+// NaN operands as quiet NaNs.
+func float32Unary(op opcode, a uint32) uint32 {
+	z := math.Float32frombits(a)
+
+	switch op {
+	case 0x8B: // f32.abs
+		return a &^ (1 << 31)
+	case 0x8C: // f32.neg
+		return a ^ (1 << 31)
+	}
+
+	if z != z {
+		return a | 0x00400000
+	}
+
+	switch op {
+	case 0x8D: // f32.ceil
+		z = float32(math.Ceil(float64(z)))
+	case 0x8E: // f32.floor
+		z = float32(math.Floor(float64(z)))
+	case 0x8F: // f32.trunc
+		z = float32(math.Trunc(float64(z)))
+	case 0x90: // f32.nearest
+		z = float32(math.RoundToEven(float64(z)))
+	default: // f32.sqrt
+		// Rounding the square root twice is exact, float64 has more than twice the precision
+		z = float32(math.Sqrt(float64(z)))
+	}
+
+	return math.Float32bits(z)
+}
+
+// float64Unary applies the f64 unary operator op to the bits of a.
+func float64Unary(op opcode, a uint64) uint64 {
+	z := math.Float64frombits(a)
+
+	switch op {
+	case 0x99: // f64.abs
+		return a &^ (1 << 63)
+	case 0x9A: // f64.neg
+		return a ^ (1 << 63)
+	}
+
+	if z != z {
+		return a | 0x0008000000000000
+	}
+
+	switch op {
+	case 0x9B: // f64.ceil
+		z = math.Ceil(z)
+	case 0x9C: // f64.floor
+		z = math.Floor(z)
+	case 0x9D: // f64.trunc
+		z = math.Trunc(z)
+	case 0x9E: // f64.nearest
+		z = math.RoundToEven(z)
+	default: // f64.sqrt
+		z = math.Sqrt(z)
+	}
+
+	return math.Float64bits(z)
+}
+
+// float32Binary applies the f32 binary operator op to the bits of a and b.
+func float32Binary(op opcode, a, b uint32) uint32 {
+	x, y := math.Float32frombits(a), math.Float32frombits(b)
+
+	switch op {
+	case 0x92: // f32.add
+		return math.Float32bits(x + y)
+	case 0x93: // f32.sub
+		return math.Float32bits(x - y)
+	case 0x94: // f32.mul
+		return math.Float32bits(x * y)
+	case 0x95: // f32.div
+		return math.Float32bits(x / y)
+	case 0x96, 0x97: // f32.min and f32.max
+		if x != x || y != y {
+			// Adding propagates the payload of a NaN operand as a quiet NaN
+			return math.Float32bits(x + y)
+		}
+		if x == y {
+			// Only zeros of different sign compare equal but differ in their bits, the
+			// minimum is -0 and the maximum +0
+			if op == 0x96 {
+				return a | b
+			}
+			return a & b
+		}
+		if (x < y) == (op == 0x96) {
+			return a
+		}
+		return b
+	default: // f32.copysign
+		return a&^(1<<31) | b&(1<<31)
+	}
+}
+
+// float64Binary applies the f64 binary operator op to the bits of a and b.
+func float64Binary(op opcode, a, b uint64) uint64 {
+	x, y := math.Float64frombits(a), math.Float64frombits(b)
+
+	switch op {
+	case 0xA0: // f64.add
+		return math.Float64bits(x + y)
+	case 0xA1: // f64.sub
+		return math.Float64bits(x - y)
+	case 0xA2: // f64.mul
+		return math.Float64bits(x * y)
+	case 0xA3: // f64.div
+		return math.Float64bits(x / y)
+	case 0xA4, 0xA5: // f64.min and f64.max
+		if x != x || y != y {
+			return math.Float64bits(x + y)
+		}
+		if x == y {
+			if op == 0xA4 {
+				return a | b
+			}
+			return a & b
+		}
+		if (x < y) == (op == 0xA4) {
+			return a
+		}
+		return b
+	default: // f64.copysign
+		return a&^(1<<63) | b&(1<<63)
+	}
+}
+
+// truncation describes the conversion of floats to an integer type, the bounds of the
+// range of representable values are exclusive because they are exact as floats.
+type truncation struct {
+	below, above float64
+	// min and max are the bits of the results of saturating out of range values
+	min, max uint64
+	convert  func(float64) uint64
+}
+
+var (
+	truncationInt32 = truncation{-2147483649.0, 2147483648.0, 0x80000000, math.MaxInt32,
+		func(z float64) uint64 { return uint64(uint32(int32(z))) }}
+	truncationUint32 = truncation{-1, 4294967296.0, 0, math.MaxUint32,
+		func(z float64) uint64 { return uint64(uint32(z)) }}
+	// -2^63-1 is not exact, the next float below -2^63 is used instead
+	truncationInt64 = truncation{-9223372036854777856.0, 9223372036854775808.0, 1 << 63, math.MaxInt64,
+		func(z float64) uint64 { return uint64(int64(z)) }}
+	truncationUint64 = truncation{-1, 18446744073709551616.0, 0, math.MaxUint64,
+		func(z float64) uint64 { return uint64(z) }}
+)
+
+// truncate converts z towards zero. It traps if z is NaN or out of range unless saturating
+// is set, which converts NaN to 0 and clamps z to the range.
+func (t truncation) truncate(z float64, saturating bool) (uint64, error) {
+	switch {
+	case z != z:
+		if saturating {
+			return 0, nil
+		}
+		return 0, ErrInvalidConversion
+	case z <= t.below:
+		if saturating {
+			return t.min, nil
+		}
+		return 0, ErrIntegerOverflow
+	case z >= t.above:
+		if saturating {
+			return t.max, nil
+		}
+		return 0, ErrIntegerOverflow
+	}
+
+	return t.convert(math.Trunc(z)), nil
+}
+
+// convert applies the conversion op to the bits of a.
+func convert(op opcode, a uint64) (uint64, error) {
+	f32 := func() float64 { return float64(math.Float32frombits(uint32(a))) }
+	f64 := func() float64 { return math.Float64frombits(a) }
+	saturating := op>>8 == 0xFC
+
+	switch op {
+	case 0xA7: // i32.wrap_i64
+		return uint64(uint32(a)), nil
+	case 0xA8, 0xFC00: // i32.trunc_f32_s
+		return truncationInt32.truncate(f32(), saturating)
+	case 0xA9, 0xFC01: // i32.trunc_f32_u
+		return truncationUint32.truncate(f32(), saturating)
+	case 0xAA, 0xFC02: // i32.trunc_f64_s
+		return truncationInt32.truncate(f64(), saturating)
+	case 0xAB, 0xFC03: // i32.trunc_f64_u
+		return truncationUint32.truncate(f64(), saturating)
+	case 0xAC: // i64.extend_i32_s
+		return uint64(int64(int32(a))), nil
+	case 0xAD: // i64.extend_i32_u
+		return uint64(uint32(a)), nil
+	case 0xAE, 0xFC04: // i64.trunc_f32_s
+		return truncationInt64.truncate(f32(), saturating)
+	case 0xAF, 0xFC05: // i64.trunc_f32_u
+		return truncationUint64.truncate(f32(), saturating)
+	case 0xB0, 0xFC06: // i64.trunc_f64_s
+		return truncationInt64.truncate(f64(), saturating)
+	case 0xB1, 0xFC07: // i64.trunc_f64_u
+		return truncationUint64.truncate(f64(), saturating)
+	case 0xB2: // f32.convert_i32_s
+		return uint64(math.Float32bits(float32(int32(a)))), nil
+	case 0xB3: // f32.convert_i32_u
+		return uint64(math.Float32bits(float32(uint32(a)))), nil
+	case 0xB4: // f32.convert_i64_s
+		return uint64(math.Float32bits(float32(int64(a)))), nil
+	case 0xB5: // f32.convert_i64_u
+		return uint64(math.Float32bits(float32(a))), nil
+	case 0xB6: // f32.demote_f64
+		return uint64(math.Float32bits(float32(f64()))), nil
+	case 0xB7: // f64.convert_i32_s
+		return math.Float64bits(float64(int32(a))), nil
+	case 0xB8: // f64.convert_i32_u
+		return math.Float64bits(float64(uint32(a))), nil
+	case 0xB9: // f64.convert_i64_s
+		return math.Float64bits(float64(int64(a))), nil
+	case 0xBA: // f64.convert_i64_u
+		return math.Float64bits(float64(a)), nil
+	case 0xBB: // f64.promote_f32
+		return math.Float64bits(f32()), nil
+	default: // reinterpretations
+		return a, nil
+	}
+}
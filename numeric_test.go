@@ -0,0 +1,47 @@
+package jwasm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncatingFloats(t *testing.T) {
+	tests := []struct {
+		op       opcode
+		z        float64
+		expected uint64
+		err      error
+	}{
+		{0xAA, 2147483647.9, 0x7FFFFFFF, nil},
+		{0xAA, -2147483648.9, 0x80000000, nil},
+		{0xAA, 2147483648, 0, ErrIntegerOverflow},
+		{0xAA, math.NaN(), 0, ErrInvalidConversion},
+		{0xB0, -9223372036854775808, 1 << 63, nil},
+		{0xB0, -9223372036854777856, 0, ErrIntegerOverflow},
+		{0xB1, 18446744073709549568, 0xFFFFFFFFFFFFF800, nil},
+		{0xB1, 18446744073709551616, 0, ErrIntegerOverflow},
+		{0xFC02, math.Inf(-1), 0x80000000, nil},
+		{0xFC06, -9223372036854777856, 1 << 63, nil},
+		{0xFC07, math.NaN(), 0, nil},
+	}
+
+	for _, test := range tests {
+		actual, err := convert(test.op, math.Float64bits(test.z))
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "%s %v", opcodeNames[test.op], test.z)
+		} else if assert.NoError(t, err, "%s %v", opcodeNames[test.op], test.z) {
+			assert.Equal(t, test.expected, actual, "%s %v", opcodeNames[test.op], test.z)
+		}
+	}
+}
+
+func TestFloatMinimumAndMaximum(t *testing.T) {
+	zero, negativeZero := math.Float64bits(0), math.Float64bits(math.Copysign(0, -1))
+
+	assert.Equal(t, negativeZero, float64Binary(0xA4, zero, negativeZero))
+	assert.Equal(t, zero, float64Binary(0xA5, negativeZero, zero))
+	assert.Equal(t, uint64(0x7FF8000000000001), float64Binary(0xA4, 0x7FF0000000000001, zero))
+	assert.Equal(t, uint32(0xBF800000), float32Binary(0x96, 0xBF800000, 0x3F800000))
+}
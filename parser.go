@@ -1,7 +1,10 @@
 package jwasm
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 )
@@ -12,43 +15,138 @@ const WASM_BINARY_VERSION uint32 = 0x01000000
 type Parser struct {
 }
 
-func (p *Parser) Parse(r io.Reader) error {
+// Parse decodes a WebAssembly module in the binary format from r. Errors are
+// returned as *DecodeError.
+func (p *Parser) Parse(r io.Reader) (*Module, error) {
+	cr := &countingReader{r: bufio.NewReader(r)}
+
 	// Read magic header
 	var magic uint32
-	err := binary.Read(r, binary.BigEndian, &magic)
+	err := binary.Read(cr, binary.BigEndian, &magic)
 	if err != nil {
-		return fmt.Errorf("reading magic failed: %w", err)
+		return nil, newDecodeError(cr.n, fmt.Errorf("%w: reading magic failed: %w", ErrBadMagic, err))
 	}
 
 	if magic != WASM_BINARY_MAGIC {
-		return fmt.Errorf("magic did not match, expected [0x%x] got, [0x%x]", WASM_BINARY_MAGIC, magic)
+		return nil, newDecodeError(0, fmt.Errorf("%w, expected [0x%x] got, [0x%x]", ErrBadMagic, WASM_BINARY_MAGIC, magic))
 	}
 
 	// Read version
 	var version uint32
-	err = binary.Read(r, binary.BigEndian, &version)
+	err = binary.Read(cr, binary.BigEndian, &version)
 	if err != nil {
-		return fmt.Errorf("reading version failed: %w", err)
+		return nil, newDecodeError(cr.n, fmt.Errorf("%w: reading version failed: %w", ErrBadVersion, err))
 	}
 
 	if version != WASM_BINARY_VERSION {
-		return fmt.Errorf("version did not match, expected [0x%x] got, [0x%x]", WASM_BINARY_VERSION, magic)
+		return nil, newDecodeError(4, fmt.Errorf("%w, expected [0x%x] got, [0x%x]", ErrBadVersion, WASM_BINARY_VERSION, version))
 	}
 
 	// Parse sections
+	module := new(Module)
+	var previous SectionId
 	for {
-		section, err := parseSection(r)
+		start := cr.n
+		section, raw, err := parseSection(cr)
 
 		if err == io.EOF {
 			break
 		}
 
+		var decodeError *DecodeError
+		if errors.As(err, &decodeError) && decodeError.FunctionIndex != nil {
+			*decodeError.FunctionIndex += uint32(len(module.importedFunctions()))
+		}
+
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		// https://webassembly.github.io/spec/core/binary/modules.html#binary-module
+		//
+		// Custom sections may be inserted anywhere, all other sections appear at most once and
+		// in a prescribed order
+		id := section.id()
+		if id != customSectionId {
+			if id == previous {
+				err := fmt.Errorf("%w, duplicate %s section", ErrSectionOrder, id)
+				return nil, sectionDecodeError(id, newDecodeError(start, err))
+			}
+
+			if previous != customSectionId && sectionOrder[id] < sectionOrder[previous] {
+				err := fmt.Errorf("%w, %s section must not follow %s section", ErrSectionOrder, id, previous)
+				return nil, sectionDecodeError(id, newDecodeError(start, err))
+			}
+
+			previous = id
+		}
+
+		module.addSection(section)
+		if raw.keep(section) {
+			if module.raw == nil {
+				module.raw = make(map[Section]rawSection)
+			}
+			module.raw[section] = raw
+		}
+	}
+
+	// https://webassembly.github.io/spec/core/binary/modules.html#binary-module
+	var numFunctions, numBodies int
+	if module.FunctionSection != nil {
+		numFunctions = len(module.FunctionSection.typeIndices)
+	}
+	if module.CodeSection != nil {
+		numBodies = len(module.CodeSection.functionCode)
+	}
+
+	if numFunctions != numBodies {
+		err := fmt.Errorf("%w, [%d] functions, [%d] bodies", ErrFunctionCodeMismatch, numFunctions, numBodies)
+		return nil, sectionDecodeError(codeSectionId, newDecodeError(cr.n, err))
+	}
+
+	if module.DataCountSection != nil {
+		var numData int
+		if module.DataSection != nil {
+			numData = len(module.DataSection.data)
 		}
 
-		fmt.Printf("Section: %+v\n", section)
+		if int(module.DataCountSection.count) != numData {
+			err := fmt.Errorf("data count and data section have inconsistent lengths, expected [%d] data segments, got [%d]", module.DataCountSection.count, numData)
+			return nil, sectionDecodeError(dataCountSectionId, newDecodeError(cr.n, err))
+		}
+	}
+
+	return module, nil
+}
+
+// countingReader keeps track of the number of bytes read so far to locate decode errors.
+type countingReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
+func (cr *countingReader) ReadByte() (byte, error) {
+	b, err := cr.r.ReadByte()
+	if err == nil {
+		cr.n++
 	}
+	return b, err
+}
 
-	return nil
+// offsetOf returns the number of bytes already read from r if r keeps track of it, 0 otherwise.
+func offsetOf(r io.Reader) int64 {
+	switch r := r.(type) {
+	case *countingReader:
+		return r.n
+	case *bytes.Reader:
+		return r.Size() - int64(r.Len())
+	default:
+		return 0
+	}
 }
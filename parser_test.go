@@ -0,0 +1,225 @@
+package jwasm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingModule(t *testing.T) {
+	// (module
+	//   (func (export "id") (param i32) (result i32)
+	//     local.get 0))
+	data := []byte{
+		0x00, 0x61, 0x73, 0x6D, 0x01, 0x00, 0x00, 0x00,
+		// Type section
+		0x01, 0x06, 0x01, 0x60, 0x01, 0x7F, 0x01, 0x7F,
+		// Function section
+		0x03, 0x02, 0x01, 0x00,
+		// Export section
+		0x07, 0x06, 0x01, 0x02, 0x69, 0x64, 0x00, 0x00,
+		// Code section
+		0x0A, 0x06, 0x01, 0x04, 0x00, 0x20, 0x00, 0x0B,
+		// Custom section
+		0x00, 0x05, 0x04, 0x6E, 0x61, 0x6D, 0x65,
+	}
+
+	parser := Parser{}
+	module, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, module.Sections, 5)
+	assert.Same(t, module.TypeSection, module.Sections[0])
+	assert.Same(t, module.FunctionSection, module.Sections[1])
+	assert.Same(t, module.ExportSection, module.Sections[2])
+	assert.Same(t, module.CodeSection, module.Sections[3])
+	assert.Len(t, module.CustomSections, 1)
+
+	assert.Len(t, module.TypeSection.FunctionTypes, 1)
+	assert.Equal(t, []uint32{0}, module.FunctionSection.typeIndices)
+	assert.Len(t, module.CodeSection.functionCode, 1)
+	assert.Equal(t, []instruction{&localGet{0}}, module.CodeSection.functionCode[0].body)
+	assert.Equal(t, "name", module.CustomSections[0].Name)
+}
+
+func TestParsingModuleWithMismatchingDataCount(t *testing.T) {
+	data := []byte{
+		0x00, 0x61, 0x73, 0x6D, 0x01, 0x00, 0x00, 0x00,
+		// Data count section
+		0x0C, 0x01, 0x02,
+		// Data section
+		0x0B, 0x03, 0x01, 0x01, 0x00,
+	}
+
+	parser := Parser{}
+	_, err := parser.Parse(bytes.NewReader(data))
+
+	assert.ErrorContains(t, err, "data count and data section have inconsistent lengths")
+}
+
+func TestParsingModuleReportsDecodeErrors(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6D, 0x01, 0x00, 0x00, 0x00}
+
+	for _, test := range []struct {
+		Name          string
+		Data          []byte
+		Sentinel      error
+		Offset        int64
+		SectionId     *SectionId
+		FunctionIndex *uint32
+	}{
+		{
+			Name:     "bad magic",
+			Data:     []byte{0x00, 0x61, 0x73, 0x6E, 0x01, 0x00, 0x00, 0x00},
+			Sentinel: ErrBadMagic,
+		},
+		{
+			Name:     "bad version",
+			Data:     []byte{0x00, 0x61, 0x73, 0x6D, 0x02, 0x00, 0x00, 0x00},
+			Sentinel: ErrBadVersion,
+			Offset:   4,
+		},
+		{
+			Name:      "truncated section",
+			Data:      append(header, 0x01, 0x05, 0x01, 0x60),
+			Sentinel:  ErrUnexpectedEOF,
+			Offset:    12,
+			SectionId: ptr(typeSectionId),
+		},
+		{
+			Name:      "unknown section",
+			Data:      append(header, 0x0D, 0x00),
+			Sentinel:  ErrUnknownSection,
+			Offset:    10,
+			SectionId: ptr(SectionId(0x0D)),
+		},
+		{
+			Name: "unknown opcode",
+			Data: append(header,
+				// Type section
+				0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+				// Import section
+				0x02, 0x07, 0x01, 0x01, 0x65, 0x01, 0x66, 0x00, 0x00,
+				// Function section
+				0x03, 0x02, 0x01, 0x00,
+				// Code section
+				0x0A, 0x05, 0x01, 0x03, 0x00, 0xFF, 0x0B,
+			),
+			Sentinel:      ErrUnknownOpcode,
+			Offset:        33,
+			SectionId:     ptr(codeSectionId),
+			FunctionIndex: ptr(uint32(1)),
+		},
+		{
+			Name:      "malformed LEB128",
+			Data:      append(header, 0x03, 0x07, 0x01, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00),
+			Sentinel:  ErrMalformedLEB,
+			Offset:    16,
+			SectionId: ptr(functionSectionId),
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			parser := Parser{}
+			_, err := parser.Parse(bytes.NewReader(test.Data))
+
+			assert.ErrorIs(t, err, test.Sentinel)
+
+			var decodeError *DecodeError
+			if assert.ErrorAs(t, err, &decodeError) {
+				assert.Equal(t, test.Offset, decodeError.Offset)
+				assert.Equal(t, test.SectionId, decodeError.SectionId)
+				assert.Equal(t, test.FunctionIndex, decodeError.FunctionIndex)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestParsingModuleEnforcesSectionStructure(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6D, 0x01, 0x00, 0x00, 0x00}
+	typeSection := []byte{0x01, 0x04, 0x01, 0x60, 0x00, 0x00}
+	functionSection := []byte{0x03, 0x02, 0x01, 0x00}
+	codeSection := []byte{0x0A, 0x04, 0x01, 0x02, 0x00, 0x0B}
+	dataCountSection := []byte{0x0C, 0x01, 0x00}
+	customSection := []byte{0x00, 0x02, 0x01, 0x78}
+
+	concat := func(parts ...[]byte) []byte {
+		return bytes.Join(append([][]byte{header}, parts...), nil)
+	}
+
+	for _, test := range []struct {
+		Name     string
+		Data     []byte
+		Sentinel error
+	}{
+		{"custom sections anywhere", concat(customSection, typeSection, customSection, functionSection, dataCountSection, codeSection, customSection), nil},
+		{"duplicate section", concat(typeSection, typeSection), ErrSectionOrder},
+		{"duplicate section separated by custom section", concat(typeSection, customSection, typeSection), ErrSectionOrder},
+		{"out of order", concat(functionSection, typeSection), ErrSectionOrder},
+		{"data count after code", concat(typeSection, functionSection, codeSection, dataCountSection), ErrSectionOrder},
+		{"section too long", concat([]byte{0x01, 0x05, 0x01, 0x60, 0x00, 0x00, 0x00}), ErrSectionSizeMismatch},
+		{"section too short", concat([]byte{0x01, 0x03, 0x01, 0x60, 0x00}), ErrUnexpectedEOF},
+		{"function body too long", concat(typeSection, functionSection, []byte{0x0A, 0x05, 0x01, 0x03, 0x00, 0x0B, 0x01}), ErrSectionSizeMismatch},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			parser := Parser{}
+			_, err := parser.Parse(bytes.NewReader(test.Data))
+
+			if test.Sentinel == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.Sentinel)
+			}
+		})
+	}
+}
+
+func TestModuleImportsAndExports(t *testing.T) {
+	data := []byte{
+		0x00, 0x61, 0x73, 0x6D, 0x01, 0x00, 0x00, 0x00,
+		// Type section: (type (func (param i32))) (type (func (result i64)))
+		0x01, 0x09, 0x02, 0x60, 0x01, 0x7F, 0x00, 0x60, 0x00, 0x01, 0x7E,
+		// Import section: (import "env" "log" (func (type 0))) (import "env" "mem" (memory 1))
+		0x02, 0x16, 0x02,
+		0x03, 0x65, 0x6E, 0x76, 0x03, 0x6C, 0x6F, 0x67, 0x00, 0x00,
+		0x03, 0x65, 0x6E, 0x76, 0x03, 0x6D, 0x65, 0x6D, 0x02, 0x00, 0x01,
+		// Function section
+		0x03, 0x02, 0x01, 0x01,
+		// Global section: (global i32 (i32.const 0))
+		0x06, 0x06, 0x01, 0x7F, 0x00, 0x41, 0x00, 0x0B,
+		// Export section: (export "run" (func 1)) (export "g" (global 0)) (export "memory" (memory 0))
+		0x07, 0x14, 0x03,
+		0x03, 0x72, 0x75, 0x6E, 0x00, 0x01,
+		0x01, 0x67, 0x03, 0x00,
+		0x06, 0x6D, 0x65, 0x6D, 0x6F, 0x72, 0x79, 0x02, 0x00,
+		// Code section
+		0x0A, 0x06, 0x01, 0x04, 0x00, 0x42, 0x00, 0x0B,
+	}
+
+	parser := Parser{}
+	module, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imports, err := module.Imports()
+	assert.NoError(t, err)
+	assert.Equal(t, []Import{
+		{Module: "env", Name: "log", Kind: ExternalKindFunc, Index: 0, FunctionType: &FunctionType{ResultType{ValueTypeI32}, nil}},
+		{Module: "env", Name: "mem", Kind: ExternalKindMemory, Index: 0, MemoryType: &MemoryType{Limits{1, nil}}},
+	}, imports)
+
+	exports, err := module.Exports()
+	assert.NoError(t, err)
+	assert.Equal(t, []Export{
+		{Name: "run", Kind: ExternalKindFunc, Index: 1, FunctionType: &FunctionType{nil, ResultType{ValueTypeI64}}},
+		{Name: "g", Kind: ExternalKindGlobal, Index: 0, GlobalType: &GlobalType{ValueTypeI32, false}},
+		{Name: "memory", Kind: ExternalKindMemory, Index: 0, MemoryType: &MemoryType{Limits{1, nil}}},
+	}, exports)
+}
@@ -0,0 +1,781 @@
+package jwasm
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Printer renders modules in the WebAssembly text format, the output can be assembled
+// again by standard tools.
+// https://webassembly.github.io/spec/core/text/index.html
+type Printer struct {
+	// Folded prints instructions as folded S-expressions where their operands are known,
+	// instead of a flat sequence of instructions.
+	Folded bool
+}
+
+// Print writes the text format of m to w. Functions and locals are named after the
+// name section of m if it is present.
+func (p *Printer) Print(w io.Writer, m *Module) error {
+	pp := &printer{Printer: p, m: m, names: m.Names()}
+	pp.nameFunctions()
+	pp.printModule()
+
+	_, err := io.WriteString(w, pp.b.String())
+	if err != nil {
+		return fmt.Errorf("writing module failed: %w", err)
+	}
+
+	return nil
+}
+
+type printer struct {
+	*Printer
+	m     *Module
+	names *NameSection
+	b     strings.Builder
+
+	// functionIds are the identifiers of functions in the function index space, empty
+	// for functions without a name
+	functionIds map[uint32]string
+}
+
+func (p *printer) line(depth int, format string, args ...any) {
+	p.b.WriteString(strings.Repeat("  ", depth))
+	fmt.Fprintf(&p.b, format, args...)
+	p.b.WriteByte('\n')
+}
+
+func (p *printer) printModule() {
+	m := p.m
+
+	if p.names != nil && p.names.ModuleName != "" {
+		p.line(0, "(module %s", identifier(p.names.ModuleName))
+	} else {
+		p.line(0, "(module")
+	}
+
+	if m.TypeSection != nil {
+		for i, functionType := range m.TypeSection.FunctionTypes {
+			p.line(1, "(type (;%d;) (func%s))", i, functionTypeText(functionType))
+		}
+	}
+
+	var numFunctions, numTables, numMemories, numGlobals uint32
+	for _, imp := range m.imports() {
+		var desc string
+		switch d := imp.importDescription.(type) {
+		case *importDescriptionFunc:
+			desc = fmt.Sprintf("(func %s (type %d)%s)", p.functionDeclarationId(numFunctions), d.typeIndex, p.typeText(d.typeIndex))
+			numFunctions++
+		case *importDescriptionTable:
+			desc = fmt.Sprintf("(table (;%d;) %s)", numTables, tableTypeText(d.tableType))
+			numTables++
+		case *importDescriptionMem:
+			desc = fmt.Sprintf("(memory (;%d;) %s)", numMemories, limitsText(d.memoryType.Limits))
+			numMemories++
+		case *importDescriptionGlobal:
+			desc = fmt.Sprintf("(global (;%d;) %s)", numGlobals, globalTypeText(d.globalType))
+			numGlobals++
+		}
+		p.line(1, "(import %s %s %s)", stringText(imp.module), stringText(imp.name), desc)
+	}
+
+	if m.FunctionSection != nil {
+		for i, typeIdx := range m.FunctionSection.typeIndices {
+			var code *functionCode
+			if m.CodeSection != nil && i < len(m.CodeSection.functionCode) {
+				code = &m.CodeSection.functionCode[i]
+			}
+			p.printFunction(numFunctions+uint32(i), typeIndex(typeIdx), code)
+		}
+	}
+
+	if m.TableSection != nil {
+		for i, tableType := range m.TableSection.tables {
+			p.line(1, "(table (;%d;) %s)", numTables+uint32(i), tableTypeText(tableType))
+		}
+	}
+
+	if m.MemorySection != nil {
+		for i, memoryType := range m.MemorySection.memories {
+			p.line(1, "(memory (;%d;) %s)", numMemories+uint32(i), limitsText(memoryType.Limits))
+		}
+	}
+
+	if m.GlobalSection != nil {
+		for i, global := range m.GlobalSection.globals {
+			p.line(1, "(global (;%d;) %s %s)", numGlobals+uint32(i), globalTypeText(global.globalType), p.expressionText(global.init))
+		}
+	}
+
+	if m.ExportSection != nil {
+		for _, exp := range m.ExportSection.exports {
+			var desc string
+			switch d := exp.exportDescription.(type) {
+			case *exportDescriptionFunc:
+				desc = fmt.Sprintf("(func %s)", p.functionId(uint32(d.functionIndex)))
+			case *exportDescriptionTable:
+				desc = fmt.Sprintf("(table %d)", d.tableIndex)
+			case *exportDescriptionMem:
+				desc = fmt.Sprintf("(memory %d)", d.memoryIndex)
+			case *exportDescriptionGlobal:
+				desc = fmt.Sprintf("(global %d)", d.globalIndex)
+			}
+			p.line(1, "(export %s %s)", stringText(exp.name), desc)
+		}
+	}
+
+	if m.StartSection != nil {
+		p.line(1, "(start %s)", p.functionId(uint32(m.StartSection.start)))
+	}
+
+	if m.ElementSection != nil {
+		for i, element := range m.ElementSection.elements {
+			p.line(1, "(elem (;%d;)%s)", i, p.elementText(element))
+		}
+	}
+
+	if m.DataSection != nil {
+		for i, data := range m.DataSection.data {
+			var mode string
+			if active, ok := data.mode.(*dataModeActive); ok {
+				if active.memory != 0 {
+					mode += fmt.Sprintf(" (memory %d)", active.memory)
+				}
+				mode += " " + p.offsetText(active.offset)
+			}
+			p.line(1, "(data (;%d;)%s %s)", i, mode, dataText(data.init))
+		}
+	}
+
+	// The text format has no representation for custom sections
+	for _, section := range m.CustomSections {
+		p.line(1, ";; custom section %s, %d bytes", stringText(section.Name), len(section.Data))
+	}
+
+	p.line(0, ")")
+}
+
+func (p *printer) printFunction(idx uint32, typeIdx typeIndex, code *functionCode) {
+	var functionType FunctionType
+	if p.m.TypeSection != nil && int(typeIdx) < len(p.m.TypeSection.FunctionTypes) {
+		functionType = p.m.TypeSection.FunctionTypes[typeIdx]
+	}
+
+	fc := p.nameLocals(idx)
+
+	header := fmt.Sprintf("(func %s (type %d)", p.functionDeclarationId(idx), typeIdx)
+	header += valueTypesText("param", fc, 0, functionType.ParameterTypes)
+	if len(functionType.ResultTypes) > 0 {
+		header += " (result " + valueTypeListText(functionType.ResultTypes) + ")"
+	}
+
+	if code == nil {
+		p.line(1, "%s)", header)
+		return
+	}
+
+	p.line(1, "%s", header)
+
+	var localTypes []ValueType
+	for _, run := range code.locals {
+		for j := uint32(0); j < run.n; j++ {
+			localTypes = append(localTypes, run.valueType)
+		}
+	}
+	if len(localTypes) > 0 {
+		p.line(2, "%s", strings.TrimPrefix(valueTypesText("local", fc, uint32(len(functionType.ParameterTypes)), localTypes), " "))
+	}
+
+	if p.Folded {
+		p.printFolded(code.body, 2, fc)
+	} else {
+		p.printFlat(code.body, 2, fc)
+	}
+
+	p.line(1, ")")
+}
+
+// functionContext is the function whose body is printed.
+type functionContext struct {
+	idx uint32
+	// localIds are the identifiers of locals with a name
+	localIds map[uint32]string
+}
+
+// valueTypesText renders parameters or locals, starting at the local index first. Named
+// entries are printed one per declaration, unnamed ones are grouped.
+func valueTypesText(keyword string, fc *functionContext, first uint32, types []ValueType) string {
+	var result string
+	var unnamed []ValueType
+
+	flush := func() {
+		if len(unnamed) > 0 {
+			result += fmt.Sprintf(" (%s %s)", keyword, valueTypeListText(unnamed))
+			unnamed = nil
+		}
+	}
+
+	for i, t := range types {
+		id, ok := fc.localIds[first+uint32(i)]
+		if !ok {
+			unnamed = append(unnamed, t)
+			continue
+		}
+
+		flush()
+		result += fmt.Sprintf(" (%s %s %s)", keyword, id, t)
+	}
+	flush()
+
+	return result
+}
+
+// Identifiers
+
+// nameFunctions assigns unique identifiers to all functions with a name.
+func (p *printer) nameFunctions() {
+	p.functionIds = make(map[uint32]string)
+	if p.names != nil {
+		p.functionIds = uniqueIdentifiers(p.names.FunctionNames)
+	}
+}
+
+// nameLocals assigns unique identifiers to the named locals of the function at idx.
+func (p *printer) nameLocals(idx uint32) *functionContext {
+	fc := &functionContext{idx: idx, localIds: make(map[uint32]string)}
+	if p.names != nil {
+		fc.localIds = uniqueIdentifiers(p.names.LocalNames[idx])
+	}
+	return fc
+}
+
+// uniqueIdentifiers turns names into identifiers, names that collide after replacing
+// invalid characters are disambiguated by their index. Empty names are skipped.
+func uniqueIdentifiers(names map[uint32]string) map[uint32]string {
+	indices := make([]uint32, 0, len(names))
+	for idx, name := range names {
+		if name != "" {
+			indices = append(indices, idx)
+		}
+	}
+	slices.Sort(indices)
+
+	result := make(map[uint32]string)
+	used := make(map[string]bool)
+	for _, idx := range indices {
+		id := identifier(names[idx])
+		if used[id] {
+			id = fmt.Sprintf("%s.%d", id, idx)
+		}
+		used[id] = true
+		result[idx] = id
+	}
+	return result
+}
+
+// functionId returns the text to reference the function at idx.
+func (p *printer) functionId(idx uint32) string {
+	if id, ok := p.functionIds[idx]; ok {
+		return id
+	}
+	return strconv.FormatUint(uint64(idx), 10)
+}
+
+// functionDeclarationId returns the text identifying the function at idx where it is declared.
+func (p *printer) functionDeclarationId(idx uint32) string {
+	if id, ok := p.functionIds[idx]; ok {
+		return id
+	}
+	return fmt.Sprintf("(;%d;)", idx)
+}
+
+// localId returns the text to reference the local at idx of the function.
+func (p *printer) localId(fc *functionContext, idx localIndex) string {
+	if id, ok := fc.localIds[uint32(idx)]; ok {
+		return id
+	}
+	return strconv.FormatUint(uint64(idx), 10)
+}
+
+// identifier turns name into a valid identifier by replacing characters that are not
+// allowed in identifiers.
+// https://webassembly.github.io/spec/core/text/values.html#text-id
+func identifier(name string) string {
+	var b strings.Builder
+	b.WriteByte('$')
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if isIdChar(c) {
+			b.WriteByte(c)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+func isIdChar(c byte) bool {
+	switch {
+	case '0' <= c && c <= '9', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		return true
+	default:
+		return strings.IndexByte("!#$%&'*+-./:<=>?@\\^_`|~", c) >= 0
+	}
+}
+
+// Types
+
+func valueTypeListText(types []ValueType) string {
+	var parts []string
+	for _, t := range types {
+		parts = append(parts, t.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+func functionTypeText(functionType FunctionType) string {
+	var result string
+	if len(functionType.ParameterTypes) > 0 {
+		result += " (param " + valueTypeListText(functionType.ParameterTypes) + ")"
+	}
+	if len(functionType.ResultTypes) > 0 {
+		result += " (result " + valueTypeListText(functionType.ResultTypes) + ")"
+	}
+	return result
+}
+
+// typeText renders the signature of the type at idx, if it exists.
+func (p *printer) typeText(idx typeIndex) string {
+	if p.m.TypeSection == nil || int(idx) >= len(p.m.TypeSection.FunctionTypes) {
+		return ""
+	}
+	return functionTypeText(p.m.TypeSection.FunctionTypes[idx])
+}
+
+func limitsText(limits Limits) string {
+	if limits.Max == nil {
+		return strconv.FormatUint(uint64(limits.Min), 10)
+	}
+	return fmt.Sprintf("%d %d", limits.Min, *limits.Max)
+}
+
+func tableTypeText(tableType TableType) string {
+	return limitsText(tableType.Limits) + " " + tableType.ElementType.String()
+}
+
+func globalTypeText(globalType GlobalType) string {
+	if globalType.Mutable {
+		return "(mut " + globalType.ValueType.String() + ")"
+	}
+	return globalType.ValueType.String()
+}
+
+// heapTypeText returns the heap type of a reference type as it is used by ref.null.
+func heapTypeText(t *referenceType) string {
+	if t == ValueTypeExternRef {
+		return "extern"
+	}
+	return "func"
+}
+
+// Segments
+
+func (p *printer) elementText(element element) string {
+	var result string
+
+	switch mode := element.mode.(type) {
+	case *elementModeActive:
+		if mode.table != 0 {
+			result += fmt.Sprintf(" (table %d)", mode.table)
+		}
+		result += " " + p.offsetText(mode.offset)
+	case *elementModeDeclarative:
+		result += " declare"
+	}
+
+	if element.functionIndices != nil {
+		result += " func"
+		for _, x := range element.functionIndices {
+			result += " " + p.functionId(uint32(x))
+		}
+		return result
+	}
+
+	result += " " + element.elementType.String()
+	for _, expression := range element.init {
+		result += " (item " + p.flatText(expression) + ")"
+	}
+	return result
+}
+
+// offsetText renders the offset of an active segment, using the abbreviation for a
+// single instruction.
+func (p *printer) offsetText(offset []instruction) string {
+	if len(offset) == 1 {
+		return p.expressionText(offset)
+	}
+	return "(offset " + p.flatText(offset) + ")"
+}
+
+// expressionText renders a constant expression as a sequence of folded instructions.
+func (p *printer) expressionText(instructions []instruction) string {
+	var parts []string
+	for _, instruction := range instructions {
+		parts = append(parts, "("+p.instructionText(instruction, nil)+")")
+	}
+	return strings.Join(parts, " ")
+}
+
+// flatText renders a constant expression as a flat sequence of instructions.
+func (p *printer) flatText(instructions []instruction) string {
+	var parts []string
+	for _, instruction := range instructions {
+		parts = append(parts, p.instructionText(instruction, nil))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Strings
+// https://webassembly.github.io/spec/core/text/values.html#strings
+
+// stringText renders a name as a string, valid UTF-8 is kept as is.
+func stringText(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError || r < 0x20 || r == 0x7F || r == '"' || r == '\\' {
+			for _, c := range []byte(s[i : i+size]) {
+				fmt.Fprintf(&b, "\\%02x", c)
+			}
+		} else {
+			b.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// dataText renders bytes as a string, escaping everything but printable ASCII.
+func dataText(data []byte) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, c := range data {
+		if c >= 0x20 && c < 0x7F && c != '"' && c != '\\' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "\\%02x", c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// Instructions
+
+func (p *printer) printFlat(instructions []instruction, depth int, fc *functionContext) {
+	for _, instruction := range instructions {
+		switch i := instruction.(type) {
+		case *block:
+			p.line(depth, "block%s", blockTypeText(i.bt))
+			p.printFlat(i.instructions, depth+1, fc)
+			p.line(depth, "end")
+		case *loop:
+			p.line(depth, "loop%s", blockTypeText(i.bt))
+			p.printFlat(i.instructions, depth+1, fc)
+			p.line(depth, "end")
+		case *ifInstruction:
+			p.line(depth, "if%s", blockTypeText(i.bt))
+			p.printFlat(i.instructions, depth+1, fc)
+			if i.elseInstructions != nil {
+				p.line(depth, "else")
+				p.printFlat(i.elseInstructions, depth+1, fc)
+			}
+			p.line(depth, "end")
+		default:
+			p.line(depth, "%s", p.instructionText(instruction, fc))
+		}
+	}
+}
+
+func (p *printer) printFolded(instructions []instruction, depth int, fc *functionContext) {
+	// pending holds folded expressions that each leave one value on the stack and have not
+	// been consumed by a following instruction yet
+	var pending []string
+
+	flush := func() {
+		for _, expression := range pending {
+			p.line(depth, "%s", expression)
+		}
+		pending = nil
+	}
+
+	for _, instruction := range instructions {
+		switch i := instruction.(type) {
+		case *block:
+			flush()
+			p.line(depth, "(block%s", blockTypeText(i.bt))
+			p.printFolded(i.instructions, depth+1, fc)
+			p.line(depth, ")")
+		case *loop:
+			flush()
+			p.line(depth, "(loop%s", blockTypeText(i.bt))
+			p.printFolded(i.instructions, depth+1, fc)
+			p.line(depth, ")")
+		case *ifInstruction:
+			var condition string
+			if len(pending) > 0 {
+				condition = pending[len(pending)-1]
+				pending = pending[:len(pending)-1]
+			}
+			flush()
+
+			p.line(depth, "(if%s", blockTypeText(i.bt))
+			if condition != "" {
+				p.line(depth+1, "%s", condition)
+			}
+			p.line(depth+1, "(then")
+			p.printFolded(i.instructions, depth+2, fc)
+			p.line(depth+1, ")")
+			if i.elseInstructions != nil {
+				p.line(depth+1, "(else")
+				p.printFolded(i.elseInstructions, depth+2, fc)
+				p.line(depth+1, ")")
+			}
+			p.line(depth, ")")
+		default:
+			pops, pushes, ok := p.stackEffect(instruction)
+			if !ok || pushes > 1 {
+				flush()
+				p.line(depth, "%s", p.instructionText(instruction, fc))
+				continue
+			}
+
+			// Operands that are not available as folded expressions stay on the stack
+			n := min(pops, len(pending))
+			expression := "(" + p.instructionText(instruction, fc)
+			for _, operand := range pending[len(pending)-n:] {
+				expression += " " + operand
+			}
+			expression += ")"
+			pending = pending[:len(pending)-n]
+
+			if pushes == 1 {
+				pending = append(pending, expression)
+			} else {
+				flush()
+				p.line(depth, "%s", expression)
+			}
+		}
+	}
+
+	flush()
+}
+
+// stackEffect returns the number of operands an instruction pops and the number of results
+// it pushes. It is not known for instructions that are stack-polymorphic or that refer to
+// types that do not exist.
+func (p *printer) stackEffect(instruction instruction) (int, int, bool) {
+	op := instruction.opcode()
+
+	switch i := instruction.(type) {
+	case *call:
+		functionType, err := p.m.functionType(i.x)
+		if err != nil {
+			return 0, 0, false
+		}
+		return len(functionType.ParameterTypes), len(functionType.ResultTypes), true
+	case *callIndirect:
+		if p.m.TypeSection == nil || int(i.y) >= len(p.m.TypeSection.FunctionTypes) {
+			return 0, 0, false
+		}
+		functionType := p.m.TypeSection.FunctionTypes[i.y]
+		return len(functionType.ParameterTypes) + 1, len(functionType.ResultTypes), true
+	case memoryAccess:
+		// Loads take an address, stores an address and a value
+		if strings.Contains(opcodeNames[op], "store") {
+			return 2, 0, true
+		}
+		return 1, 1, true
+	case *nop, *elemDrop, *dataDrop:
+		return 0, 0, true
+	case *drop, *localSet, *globalSet:
+		return 1, 0, true
+	case *localGet, *globalGet, *tableSize, *memorySize, *refNull, *refFunc,
+		*int32Const, *int64Const, *float32Const, *float64Const:
+		return 0, 1, true
+	case *localTee, *tableGet, *memoryGrow, *refIsNull:
+		return 1, 1, true
+	case *tableSet:
+		return 2, 0, true
+	case *tableGrow:
+		return 2, 1, true
+	case *selectInstruction, *selectTyped:
+		return 3, 1, true
+	case *tableFill, *tableCopy, *tableInit, *memoryFill, *memoryCopy, *memoryInit:
+		return 3, 0, true
+	}
+
+	// Numeric instructions
+	// https://webassembly.github.io/spec/core/appendix/index-instructions.html
+	switch {
+	case op == 0x45 || op == 0x50:
+		return 1, 1, true
+	case op >= 0x46 && op <= 0x66:
+		return 2, 1, true
+	case op >= 0x67 && op <= 0x69, op >= 0x79 && op <= 0x7B, op >= 0x8B && op <= 0x91, op >= 0x99 && op <= 0x9F:
+		return 1, 1, true
+	case op >= 0x6A && op <= 0x78, op >= 0x7C && op <= 0x8A, op >= 0x92 && op <= 0x98, op >= 0xA0 && op <= 0xA6:
+		return 2, 1, true
+	case op >= 0xA7 && op <= 0xC4, op >= 0xFC00 && op <= 0xFC07:
+		return 1, 1, true
+	}
+
+	return 0, 0, false
+}
+
+func blockTypeText(bt blockType) string {
+	switch bt := bt.(type) {
+	case *blockTypeValue:
+		return " (result " + bt.t.String() + ")"
+	case *blockTypeIndex:
+		return fmt.Sprintf(" (type %d)", bt.x)
+	default:
+		return ""
+	}
+}
+
+// instructionText renders a plain instruction with its immediates. fc is nil for
+// instructions outside of a function body.
+func (p *printer) instructionText(instruction instruction, fc *functionContext) string {
+	name := opcodeNames[instruction.opcode()]
+
+	switch i := instruction.(type) {
+	case *br:
+		return fmt.Sprintf("%s %d", name, i.l)
+	case *brIf:
+		return fmt.Sprintf("%s %d", name, i.l)
+	case *brTable:
+		result := name
+		for _, l := range i.l {
+			result += fmt.Sprintf(" %d", l)
+		}
+		return result + fmt.Sprintf(" %d", i.lN)
+	case *call:
+		return name + " " + p.functionId(uint32(i.x))
+	case *callIndirect:
+		if i.x != 0 {
+			return fmt.Sprintf("%s %d (type %d)", name, i.x, i.y)
+		}
+		return fmt.Sprintf("%s (type %d)", name, i.y)
+	case *refNull:
+		return name + " " + heapTypeText(i.t)
+	case *refFunc:
+		return name + " " + p.functionId(uint32(i.x))
+	case *selectTyped:
+		return name + " (result " + valueTypeListText(i.t) + ")"
+	case *localGet:
+		return name + " " + p.localId(fc, i.x)
+	case *localSet:
+		return name + " " + p.localId(fc, i.x)
+	case *localTee:
+		return name + " " + p.localId(fc, i.x)
+	case *globalGet:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *globalSet:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *tableGet:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *tableSet:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *tableInit:
+		return fmt.Sprintf("%s %d %d", name, i.x, i.y)
+	case *elemDrop:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *tableCopy:
+		return fmt.Sprintf("%s %d %d", name, i.x, i.y)
+	case *tableGrow:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *tableSize:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *tableFill:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case memoryAccess:
+		return name + memoryArgumentText(i.memoryArgument(), naturalAlignment(i.opcode()))
+	case *memoryInit:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *dataDrop:
+		return fmt.Sprintf("%s %d", name, i.x)
+	case *int32Const:
+		return fmt.Sprintf("%s %d", name, i.n)
+	case *int64Const:
+		return fmt.Sprintf("%s %d", name, i.n)
+	case *float32Const:
+		return name + " " + float32Text(i.z)
+	case *float64Const:
+		return name + " " + float64Text(i.z)
+	default:
+		return name
+	}
+}
+
+func memoryArgumentText(m memoryArgument, natural uint32) string {
+	var result string
+	if m.offset != 0 {
+		result += fmt.Sprintf(" offset=%d", m.offset)
+	}
+	if m.align != natural && m.align < 64 {
+		result += fmt.Sprintf(" align=%d", uint64(1)<<m.align)
+	}
+	return result
+}
+
+// https://webassembly.github.io/spec/core/text/values.html#floating-point
+
+func float32Text(z float32) string {
+	bits := math.Float32bits(z)
+	sign := ""
+	if bits>>31 != 0 {
+		sign = "-"
+	}
+
+	exponent, payload := (bits>>23)&0xFF, bits&0x7FFFFF
+	switch {
+	case exponent == 0xFF && payload == 0:
+		return sign + "inf"
+	case exponent == 0xFF && payload == 1<<22:
+		return sign + "nan"
+	case exponent == 0xFF:
+		return fmt.Sprintf("%snan:0x%x", sign, payload)
+	default:
+		return strconv.FormatFloat(float64(z), 'g', -1, 32)
+	}
+}
+
+func float64Text(z float64) string {
+	bits := math.Float64bits(z)
+	sign := ""
+	if bits>>63 != 0 {
+		sign = "-"
+	}
+
+	exponent, payload := (bits>>52)&0x7FF, bits&0xFFFFFFFFFFFFF
+	switch {
+	case exponent == 0x7FF && payload == 0:
+		return sign + "inf"
+	case exponent == 0x7FF && payload == 1<<51:
+		return sign + "nan"
+	case exponent == 0x7FF:
+		return fmt.Sprintf("%snan:0x%x", sign, payload)
+	default:
+		return strconv.FormatFloat(z, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,106 @@
+package jwasm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func printerTestModule() *Module {
+	module := &Module{}
+	module.addSection(&TypeSection{[]FunctionType{{ResultType{ValueTypeI32, ValueTypeI32}, ResultType{ValueTypeI32}}}})
+	module.addSection(&FunctionSection{[]uint32{0}})
+	module.addSection(&MemorySection{[]MemoryType{{Limits{1, nil}}}})
+	module.addSection(&ExportSection{[]export{{"add", &exportDescriptionFunc{0}}}})
+	module.addSection(&CodeSection{[]functionCode{{[]locals{{1, ValueTypeI64}}, []instruction{
+		&localGet{0},
+		&localGet{1},
+		&int32Add{},
+		&ifInstruction{&blockTypeValue{ValueTypeI32}, []instruction{&int32Const{1}}, []instruction{&int32Const{0}}},
+	}}}})
+	module.addSection(&DataSection{[]data{{[]byte("a\"\x00"), &dataModeActive{0, []instruction{&int32Const{16}}}}}})
+	module.addSection(&CustomSection{"name", nameSectionTestData})
+	return module
+}
+
+func TestPrintingModule(t *testing.T) {
+	var b strings.Builder
+	err := (&Printer{}).Print(&b, printerTestModule())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, `(module $m
+  (type (;0;) (func (param i32 i32) (result i32)))
+  (func $add (type 0) (param $a i32) (param $b i32) (result i32)
+    (local i64)
+    local.get $a
+    local.get $b
+    i32.add
+    if (result i32)
+      i32.const 1
+    else
+      i32.const 0
+    end
+  )
+  (memory (;0;) 1)
+  (export "add" (func $add))
+  (data (;0;) (i32.const 16) "a\22\00")
+  ;; custom section "name", 26 bytes
+)
+`, b.String())
+}
+
+func TestPrintingModuleFolded(t *testing.T) {
+	var b strings.Builder
+	err := (&Printer{Folded: true}).Print(&b, printerTestModule())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, b.String(), `    (if (result i32)
+      (i32.add (local.get $a) (local.get $b))
+      (then
+        (i32.const 1)
+      )
+      (else
+        (i32.const 0)
+      )
+    )
+`)
+}
+
+func TestPrintingDecodedModule(t *testing.T) {
+	data, err := hex.DecodeString(encoderTestModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := Parser{}
+	module, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	err = (&Printer{}).Print(&b, module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, b.String(), `(import "env" "f" (func $f (type 0)))`)
+	assert.Contains(t, b.String(), "      br_if 0\n")
+	assert.Contains(t, b.String(), "    i32.load offset=10000\n")
+	assert.Contains(t, b.String(), "  (global (;2;) f32 (f32.const nan:0x200001))\n")
+	assert.Contains(t, b.String(), "  (elem (;0;) (i32.const 0) func $f 1)\n")
+	assert.Contains(t, b.String(), "  (elem (;5;) externref (item ref.null extern))\n")
+}
+
+func TestPrintingIdentifiers(t *testing.T) {
+	assert.Equal(t, "$a_b", identifier("a b"))
+	assert.Equal(t, map[uint32]string{1: "$f", 3: "$f.3"}, uniqueIdentifiers(map[uint32]string{1: "f", 2: "", 3: "f"}))
+	assert.Equal(t, `"\c3( ü"`, stringText("\xc3( ü"))
+}
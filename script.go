@@ -0,0 +1,678 @@
+package jwasm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// ScriptStatus is the outcome of a single directive of a script.
+type ScriptStatus int
+
+const (
+	ScriptPassed ScriptStatus = iota
+	ScriptFailed
+	// ScriptSkipped marks directives that need features jwasm does not support.
+	ScriptSkipped
+)
+
+func (s ScriptStatus) String() string {
+	switch s {
+	case ScriptPassed:
+		return "passed"
+	case ScriptFailed:
+		return "failed"
+	case ScriptSkipped:
+		return "skipped"
+	default:
+		return fmt.Sprintf("status(%d)", int(s))
+	}
+}
+
+// ScriptResult reports the outcome of one directive of a script.
+type ScriptResult struct {
+	File      string
+	Line      int
+	Directive string
+	Status    ScriptStatus
+	// Err is the reason why the directive failed or was skipped, nil if it passed.
+	Err error
+}
+
+func (r ScriptResult) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("%s:%d: %s %s: %v", r.File, r.Line, r.Directive, r.Status, r.Err)
+	}
+	return fmt.Sprintf("%s:%d: %s %s", r.File, r.Line, r.Directive, r.Status)
+}
+
+// ScriptRunner runs scripts in the format of the WebAssembly specification test suite.
+// https://github.com/WebAssembly/spec/tree/main/interpreter#scripts
+type ScriptRunner struct {
+}
+
+// scriptRun holds the state of one script while it runs.
+type scriptRun struct {
+	results []ScriptResult
+	// all modules are instantiated in store, linker resolves their imports to the spectest
+	// module and the registered modules
+	store  *Store
+	linker *Linker
+
+	// current is the most recently defined module, modules holds the named ones
+	current *scriptModule
+	modules map[string]*scriptModule
+}
+
+// scriptModule is a module defined by a script and its instance.
+type scriptModule struct {
+	module   *Module
+	instance *Instance
+	// err is the reason why the module could not be instantiated if instance is nil
+	err error
+}
+
+// Run runs all directives of the script read from src, file is used to report their
+// location. An error is only returned if the script itself fails to parse.
+func (r *ScriptRunner) Run(file string, src io.Reader) ([]ScriptResult, error) {
+	text, err := io.ReadAll(src)
+	if err != nil {
+		return nil, fmt.Errorf("reading script failed: %w", err)
+	}
+
+	directives, err := parseSExpressions(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("parsing script [%s] failed: %w", file, err)
+	}
+
+	// A script may consist of the fields of a single module instead of directives
+	if len(directives) > 0 && isModuleField(directives[0]) {
+		directives = []*sexpr{inlineModule(directives)}
+	}
+
+	store := NewStore()
+	run := &scriptRun{
+		store:   store,
+		linker:  newSpectestLinker(store),
+		modules: make(map[string]*scriptModule),
+	}
+
+	for _, directive := range directives {
+		if !directive.list || len(directive.children) == 0 || directive.children[0].token.kind != tokenKeyword {
+			pos := directive.token.pos
+			return run.results, fmt.Errorf("parsing script [%s] failed: %w", file, syntaxErrorf(pos, ErrUnexpectedToken, "expected directive, got [%s]", directive))
+		}
+
+		status, err := run.directive(directive)
+		run.results = append(run.results, ScriptResult{
+			File:      file,
+			Line:      directive.token.pos.line,
+			Directive: directive.children[0].token.text,
+			Status:    status,
+			Err:       err,
+		})
+	}
+
+	return run.results, nil
+}
+
+// isModuleField checks whether expr is a module field rather than a directive.
+func isModuleField(expr *sexpr) bool {
+	for _, field := range []string{"type", "import", "func", "table", "memory", "global", "export", "start", "elem", "data"} {
+		if expr.isList(field) {
+			return true
+		}
+	}
+	return false
+}
+
+func (run *scriptRun) directive(directive *sexpr) (ScriptStatus, error) {
+	c := newCursor(directive)
+
+	switch directive.children[0].token.text {
+	case "module":
+		id, module, err := decodeScriptModule(directive)
+		if err == nil {
+			err = Validate(module)
+		}
+
+		// Actions after a module that fails to load must not run against its predecessor
+		if err != nil {
+			run.current = nil
+			return ScriptFailed, err
+		}
+
+		instance, err := run.linker.Instantiate(context.Background(), run.store, module)
+		run.current = &scriptModule{module, instance, err}
+
+		if id != "" {
+			run.modules[id] = run.current
+		}
+		return scriptStatus(err), err
+	case "register":
+		// https://github.com/WebAssembly/spec/tree/main/interpreter#scripts
+		name, err := c.string()
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		module, err := run.module(c)
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		if module.instance == nil {
+			return ScriptFailed, fmt.Errorf("module was not instantiated: %w", module.err)
+		}
+
+		run.linker.DefineInstance(name, module.instance)
+		return ScriptPassed, nil
+	case "invoke", "get":
+		action, err := run.action(directive)
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		_, err = action.perform()
+		return scriptStatus(err), err
+	case "assert_return":
+		action := c.next()
+		if action == nil || !action.list {
+			return ScriptFailed, c.unexpected("action")
+		}
+
+		a, err := run.action(action)
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		var expected []scriptResult
+		for !c.done() {
+			result, err := parseScriptResult(c.next())
+			if err != nil {
+				return ScriptFailed, err
+			}
+			expected = append(expected, result)
+		}
+
+		actual, err := a.perform()
+		if err != nil {
+			return scriptStatus(err), err
+		}
+
+		if len(actual) != len(expected) {
+			return ScriptFailed, fmt.Errorf("expected %d results, got %d", len(expected), len(actual))
+		}
+		for i, result := range expected {
+			if !result.matches(actual[i]) {
+				return ScriptFailed, fmt.Errorf("result [%d]: expected %v, got %v", i, result.value, actual[i])
+			}
+		}
+		return ScriptPassed, nil
+	case "assert_trap", "assert_exhaustion":
+		action := c.next()
+		if action == nil || !action.list {
+			return ScriptFailed, c.unexpected("action")
+		}
+
+		message, err := c.string()
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		if action.isList("module") {
+			_, module, err := decodeScriptModule(action)
+			if err != nil {
+				return ScriptFailed, err
+			}
+
+			_, err = run.linker.Instantiate(context.Background(), run.store, module)
+			return scriptTrap(err, message)
+		}
+
+		a, err := run.action(action)
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		_, err = a.perform()
+		return scriptTrap(err, message)
+	case "assert_malformed":
+		module := c.next()
+		if module == nil || !module.isList("module") {
+			return ScriptFailed, c.unexpected("module")
+		}
+
+		message, err := c.string()
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		_, _, err = decodeScriptModule(module)
+		return scriptRejection(err, "malformed", message)
+	case "assert_invalid", "assert_unlinkable", "assert_uninstantiable":
+		module := c.next()
+		if module == nil || !module.isList("module") {
+			return ScriptFailed, c.unexpected("module")
+		}
+
+		_, m, err := decodeScriptModule(module)
+		if err != nil {
+			return ScriptFailed, fmt.Errorf("module is malformed: %w", err)
+		}
+
+		switch directive.children[0].token.text {
+		case "assert_unlinkable":
+			_, err := run.linker.Instantiate(context.Background(), run.store, m)
+			switch {
+			case err == nil:
+				return ScriptFailed, errors.New("module is linkable")
+			case errors.Is(err, ErrUnknownImport) || errors.Is(err, ErrIncompatibleImportType):
+				return ScriptPassed, nil
+			default:
+				return scriptStatus(err), err
+			}
+		case "assert_uninstantiable":
+			if err := Validate(m); err != nil {
+				return ScriptFailed, fmt.Errorf("module is invalid: %w", err)
+			}
+
+			message, err := c.string()
+			if err != nil {
+				return ScriptFailed, err
+			}
+
+			_, err = run.linker.Instantiate(context.Background(), run.store, m)
+			return scriptTrap(err, message)
+		}
+
+		message, err := c.string()
+		if err != nil {
+			return ScriptFailed, err
+		}
+
+		return scriptRejection(Validate(m), "invalid", message)
+	default:
+		// Meta commands such as script, input and output are not part of the test suite
+		return ScriptSkipped, fmt.Errorf("unsupported directive [%s]", directive.children[0].token.text)
+	}
+}
+
+// spectestModule defines the globals, table and memory of the spectest module.
+const spectestModule = `(module
+  (global (export "global_i32") i32 (i32.const 666))
+  (global (export "global_i64") i64 (i64.const 666))
+  (global (export "global_f32") f32 (f32.const 666.6))
+  (global (export "global_f64") f64 (f64.const 666.6))
+  (table (export "table") 10 20 funcref)
+  (memory (export "memory") 1 2))`
+
+// newSpectestLinker returns a linker that defines the spectest module, which scripts of the
+// test suite import, in the store s. Its functions print nothing.
+// https://github.com/WebAssembly/spec/tree/main/interpreter#spectest-host-module
+func newSpectestLinker(s *Store) *Linker {
+	linker := &Linker{}
+	for name, fn := range map[string]any{
+		"print":         func() {},
+		"print_i32":     func(int32) {},
+		"print_i64":     func(int64) {},
+		"print_f32":     func(float32) {},
+		"print_f64":     func(float64) {},
+		"print_i32_f32": func(int32, float32) {},
+		"print_f64_f64": func(float64, float64) {},
+	} {
+		if err := linker.DefineFunc("spectest", name, fn); err != nil {
+			panic(err)
+		}
+	}
+
+	m, err := (&Assembler{}).Assemble(strings.NewReader(spectestModule))
+	if err != nil {
+		panic(err)
+	}
+
+	instance, err := linker.Instantiate(context.Background(), s, m)
+	if err != nil {
+		panic(err)
+	}
+
+	linker.DefineInstance("spectest", instance)
+	return linker
+}
+
+// scriptStatus returns the status of a directive that failed with err, which is skipped if
+// it needs a feature that is not supported.
+func scriptStatus(err error) ScriptStatus {
+	switch {
+	case err == nil:
+		return ScriptPassed
+	case errors.Is(err, errors.ErrUnsupported):
+		return ScriptSkipped
+	default:
+		return ScriptFailed
+	}
+}
+
+// scriptTrap returns the status of a directive that expects a trap with message, err is the
+// error of executing its action.
+func scriptTrap(err error, message string) (ScriptStatus, error) {
+	var trap *Trap
+	switch {
+	case err == nil:
+		return ScriptFailed, fmt.Errorf("expected trap [%s]", message)
+	case errors.Is(err, errors.ErrUnsupported):
+		return ScriptSkipped, err
+	case !errors.As(err, &trap) || !scriptMatches(err, message):
+		return ScriptFailed, fmt.Errorf("expected trap [%s], got: %w", message, err)
+	default:
+		return ScriptPassed, nil
+	}
+}
+
+// scriptMessages map the beginnings of messages that scripts expect, which are those of the
+// reference interpreter, to the sentinel errors jwasm reports for the same reason in other
+// words.
+var scriptMessages = []struct {
+	prefix string
+	err    error
+}{
+	{"unexpected end", ErrUnexpectedEOF},
+	{"length out of bounds", ErrUnexpectedEOF},
+	{"END opcode expected", ErrUnexpectedEOF},
+	{"illegal opcode", ErrUnknownOpcode},
+	{"unexpected content after last section", ErrSectionOrder},
+	{"unknown operator", ErrUnknownOperator},
+	{"unknown label", ErrUnknownIdentifier},
+	{"duplicate", ErrDuplicateIdentifier},
+	{"i32 constant", ErrConstantOutOfRange},
+	{"memory size must be at most", ErrLimitsOutOfRange},
+	{"size minimum must not be greater than maximum", ErrLimitsOutOfRange},
+	{"unknown function", ErrUnknownFunction},
+	{"unknown table", ErrUnknownTable},
+	{"unknown memory", ErrUnknownMemory},
+	{"unknown global", ErrUnknownGlobal},
+	{"unknown elem segment", ErrUnknownElementSegment},
+	{"unknown data segment", ErrUnknownDataSegment},
+	{"uninitialized element", ErrUninitializedElement},
+}
+
+// scriptMatches reports whether err is the error a script expects with message, either
+// literally or by the sentinel error that message maps to.
+func scriptMatches(err error, message string) bool {
+	if strings.Contains(err.Error(), message) {
+		return true
+	}
+
+	for _, m := range scriptMessages {
+		if strings.HasPrefix(message, m.prefix) && errors.Is(err, m.err) {
+			return true
+		}
+	}
+	return false
+}
+
+// scriptRejection returns the status of a directive that expects a module to be rejected as
+// malformed or invalid with message, err is the error of decoding or validating it.
+func scriptRejection(err error, rejection string, message string) (ScriptStatus, error) {
+	switch {
+	case err == nil:
+		return ScriptFailed, fmt.Errorf("module is not %s, expected [%s]", rejection, message)
+	case errors.Is(err, errors.ErrUnsupported):
+		return ScriptSkipped, err
+	case !scriptMatches(err, message):
+		return ScriptFailed, fmt.Errorf("expected module to be %s with [%s], got: %w", rejection, message, err)
+	default:
+		return ScriptPassed, nil
+	}
+}
+
+// module consumes an optional module identifier and returns the module it refers to.
+func (run *scriptRun) module(c *cursor) (*scriptModule, error) {
+	if c.peekKind(tokenId) {
+		id := c.peek().token.text
+		module, ok := run.modules[id]
+		if !ok {
+			return nil, c.errorf(ErrUnknownIdentifier, "module %s", id)
+		}
+		c.next()
+		return module, nil
+	}
+
+	if run.current == nil {
+		return nil, c.errorf(ErrUnknownIdentifier, "no module defined")
+	}
+	return run.current, nil
+}
+
+// scriptAction is an invocation of an exported function or a read of an exported global.
+type scriptAction struct {
+	module *scriptModule
+	// get is set for reads of globals
+	get       bool
+	name      string
+	arguments []any
+}
+
+// perform executes the action and returns its results.
+func (a *scriptAction) perform() ([]any, error) {
+	instance := a.module.instance
+	if instance == nil {
+		return nil, fmt.Errorf("module was not instantiated: %w", a.module.err)
+	}
+
+	if a.get {
+		value, err := instance.Global(a.name)
+		if err != nil {
+			return nil, err
+		}
+		return []any{value}, nil
+	}
+
+	return instance.Call(a.name, a.arguments...)
+}
+
+func (run *scriptRun) action(list *sexpr) (*scriptAction, error) {
+	// https://github.com/WebAssembly/spec/tree/main/interpreter#scripts
+	if !list.isList("invoke") && !list.isList("get") {
+		return nil, syntaxErrorf(list.token.pos, ErrUnexpectedToken, "expected action, got [%s]", list)
+	}
+
+	c := newCursor(list)
+	module, err := run.module(c)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := c.name()
+	if err != nil {
+		return nil, err
+	}
+
+	action := &scriptAction{module: module, get: list.isList("get"), name: name}
+	for !c.done() {
+		value, err := parseScriptValue(c.next())
+		if err != nil {
+			return nil, err
+		}
+		action.arguments = append(action.arguments, value)
+	}
+
+	return action, nil
+}
+
+// decodeScriptModule decodes a module given in the text format, as binary or as quoted text,
+// and returns the module and its identifier.
+func decodeScriptModule(list *sexpr) (string, *Module, error) {
+	c := newCursor(list)
+	id := c.id()
+
+	switch {
+	case c.keyword("binary"):
+		var b bytes.Buffer
+		for !c.done() {
+			s, err := c.string()
+			if err != nil {
+				return id, nil, err
+			}
+			b.WriteString(s)
+		}
+
+		module, err := (&Parser{}).Parse(&b)
+		return id, module, err
+	case c.keyword("quote"):
+		var b strings.Builder
+		for !c.done() {
+			s, err := c.string()
+			if err != nil {
+				return id, nil, err
+			}
+			b.WriteString(s)
+			b.WriteByte(' ')
+		}
+
+		module, err := (&Assembler{}).Assemble(strings.NewReader(b.String()))
+		return id, module, err
+	default:
+		module, err := assembleModule(list)
+		return id, module, err
+	}
+}
+
+// Values
+
+// hostReference is the value of an external reference created by a script.
+type hostReference uint32
+
+// parseScriptValue parses a constant argument of an action. Numbers are returned as int32,
+// int64, float32 and float64, null references as nil.
+func parseScriptValue(value *sexpr) (any, error) {
+	if !value.list || len(value.children) == 0 {
+		return nil, syntaxErrorf(value.token.pos, ErrUnexpectedToken, "expected constant, got [%s]", value)
+	}
+
+	c := newCursor(value)
+	var result any
+	var err error
+
+	switch value.children[0].token.text {
+	case "i32.const":
+		var n uint64
+		n, err = c.integer(32)
+		result = int32(uint32(n))
+	case "i64.const":
+		var n uint64
+		n, err = c.integer(64)
+		result = int64(n)
+	case "f32.const":
+		var z uint64
+		z, err = c.float(32)
+		result = math.Float32frombits(uint32(z))
+	case "f64.const":
+		var z uint64
+		z, err = c.float(64)
+		result = math.Float64frombits(z)
+	case "ref.null":
+		if !c.keyword("func") && !c.keyword("extern") {
+			return nil, c.unexpected("heap type")
+		}
+	case "ref.extern":
+		var n uint32
+		n, err = c.uint32()
+		result = hostReference(n)
+	default:
+		return nil, syntaxErrorf(value.token.pos, ErrUnknownOperator, "unsupported constant [%s]", value)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return result, c.end()
+}
+
+// nanPattern restricts the NaN values that match an expected float result.
+// https://github.com/WebAssembly/spec/tree/main/interpreter#scripts
+type nanPattern int
+
+const (
+	nanNone nanPattern = iota
+	// nanCanonical matches NaNs with only the most significant payload bit set.
+	nanCanonical
+	// nanArithmetic matches NaNs with the most significant payload bit set.
+	nanArithmetic
+)
+
+// scriptResult is an expected result of an action.
+type scriptResult struct {
+	value any
+	nan   nanPattern
+	// anyFunction is set for ref.func results, which match any non-null function reference
+	anyFunction bool
+}
+
+func parseScriptResult(result *sexpr) (scriptResult, error) {
+	if result.list && len(result.children) == 2 && !result.children[1].list {
+		pattern := map[string]nanPattern{"nan:canonical": nanCanonical, "nan:arithmetic": nanArithmetic}[result.children[1].token.text]
+
+		switch {
+		case pattern != nanNone && result.isList("f32.const"):
+			return scriptResult{value: float32(0), nan: pattern}, nil
+		case pattern != nanNone && result.isList("f64.const"):
+			return scriptResult{value: float64(0), nan: pattern}, nil
+		}
+	}
+
+	if result.isList("ref.func") && len(result.children) == 1 {
+		return scriptResult{anyFunction: true}, nil
+	}
+
+	value, err := parseScriptValue(result)
+	return scriptResult{value: value}, err
+}
+
+// matches returns true if actual is the expected result. NaNs have to match bit for bit
+// unless there is a pattern.
+func (r scriptResult) matches(actual any) bool {
+	if r.anyFunction {
+		_, isHost := actual.(hostReference)
+		return actual != nil && !isHost
+	}
+
+	switch expected := r.value.(type) {
+	case float32:
+		z, ok := actual.(float32)
+		if !ok {
+			return false
+		}
+
+		bits := math.Float32bits(z)
+		switch r.nan {
+		case nanCanonical:
+			return bits&0x7FFFFFFF == 0x7FC00000
+		case nanArithmetic:
+			return bits&0x7FC00000 == 0x7FC00000
+		default:
+			return bits == math.Float32bits(expected)
+		}
+	case float64:
+		z, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+
+		bits := math.Float64bits(z)
+		switch r.nan {
+		case nanCanonical:
+			return bits&0x7FFFFFFFFFFFFFFF == 0x7FF8000000000000
+		case nanArithmetic:
+			return bits&0x7FF8000000000000 == 0x7FF8000000000000
+		default:
+			return bits == math.Float64bits(expected)
+		}
+	default:
+		return r.value == actual
+	}
+}
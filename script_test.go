@@ -0,0 +1,153 @@
+package jwasm
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unsupportedProposals are the file name prefixes of upstream spec scripts that test
+// proposals jwasm does not support.
+var unsupportedProposals = []string{"simd_", "relaxed_"}
+
+// rejectedDifferently are directives of upstream spec scripts that expect a module to be
+// rejected for a reason that jwasm reports differently, by the reason why jwasm does.
+var rejectedDifferently = map[string]string{
+	"upstream/binary-leb128.wast:347":    "the reference interpreter reads the index beyond the end of the section",
+	"upstream/binary-leb128.wast:1072":   "function type headers are bytes, not LEB128 integers",
+	"upstream/binary.wast:92":            "the reference interpreter reads the function body beyond the end of the section",
+	"upstream/binary.wast:112":           "the reference interpreter reads the initializer beyond the end of the section",
+	"upstream/binary.wast:473":           "a missing data count section is reported when validating function bodies",
+	"upstream/binary.wast:495":           "a missing data count section is reported when validating function bodies",
+	"upstream/binary.wast:776":           "limits flags are bytes, not LEB128 integers",
+	"upstream/binary.wast:785":           "limits flags are bytes, not LEB128 integers",
+	"upstream/binary.wast:795":           "limits flags are bytes, not LEB128 integers",
+	"upstream/binary.wast:823":           "limits flags are bytes, not LEB128 integers",
+	"upstream/binary.wast:831":           "limits flags are bytes, not LEB128 integers",
+	"upstream/binary.wast:840":           "limits flags are bytes, not LEB128 integers",
+	"upstream/binary.wast:849":           "limits flags are bytes, not LEB128 integers",
+	"upstream/obsolete-keywords.wast:40": "anyfunc is reported as an unexpected value type",
+}
+
+func TestRunningSpecScripts(t *testing.T) {
+	files, err := filepath.Glob("testdata/spec/*.wast")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	upstream, err := filepath.Glob("testdata/spec/upstream/*.wast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, upstream...)
+
+	for _, file := range files {
+		name, _ := filepath.Rel("testdata/spec", file)
+		t.Run(name, func(t *testing.T) {
+			for _, prefix := range unsupportedProposals {
+				if filepath.Dir(file) != "testdata/spec" && strings.HasPrefix(filepath.Base(file), prefix) {
+					t.Skip("tests an unsupported proposal")
+				}
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			results, err := (&ScriptRunner{}).Run(file, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			skipped := 0
+			for _, result := range results {
+				if reason, ok := rejectedDifferently[fmt.Sprintf("%s:%d", name, result.Line)]; ok {
+					if result.Status != ScriptFailed {
+						t.Errorf("%s:%d: expected to be rejected differently because %s, got: %s", name, result.Line, reason, result.Status)
+					}
+					skipped++
+					continue
+				}
+
+				switch result.Status {
+				case ScriptFailed:
+					t.Error(result)
+				case ScriptSkipped:
+					skipped++
+				}
+			}
+
+			if skipped > 0 {
+				t.Logf("skipped %d of %d directives", skipped, len(results))
+			}
+		})
+	}
+}
+
+func TestRunningScriptReportsDirectives(t *testing.T) {
+	script := `(module $m (func (export "f")))
+(assert_malformed (module quote "(func nop)") "not malformed")
+
+(invoke $missing "f")`
+
+	results, err := (&ScriptRunner{}).Run("test.wast", strings.NewReader(script))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, ScriptResult{"test.wast", 1, "module", ScriptPassed, nil}, results[0])
+		assert.Equal(t, 2, results[1].Line)
+		assert.Equal(t, ScriptFailed, results[1].Status)
+		assert.Equal(t, 4, results[2].Line)
+		assert.ErrorIs(t, results[2].Err, ErrUnknownIdentifier)
+		assert.Equal(t, "test.wast:4: invoke failed: syntax error at line [4], column [9]: unknown identifier, module $missing", results[2].String())
+	}
+}
+
+func TestMatchingScriptResults(t *testing.T) {
+	tests := []struct {
+		result   string
+		actual   any
+		expected bool
+	}{
+		{"(i32.const -1)", int32(-1), true},
+		{"(i32.const -1)", int64(-1), false},
+		{"(i64.const 0xffffffffffffffff)", int64(-1), true},
+		{"(f32.const -0)", float32(0), false},
+		{"(f32.const nan:0x200000)", math.Float32frombits(0x7FA00000), true},
+		{"(f32.const nan:0x200000)", math.Float32frombits(0x7FC00000), false},
+		{"(f32.const nan:canonical)", math.Float32frombits(0xFFC00000), true},
+		{"(f32.const nan:canonical)", math.Float32frombits(0x7FC00001), false},
+		{"(f32.const nan:arithmetic)", math.Float32frombits(0x7FC00001), true},
+		{"(f32.const nan:arithmetic)", math.Float32frombits(0x7F800001), false},
+		{"(f64.const nan:canonical)", math.Float64frombits(0x7FF8000000000000), true},
+		{"(f64.const nan:canonical)", math.NaN(), false},
+		{"(f64.const nan:arithmetic)", math.Float64frombits(0xFFF8000000000001), true},
+		{"(f64.const nan:arithmetic)", float32(math.NaN()), false},
+		{"(ref.null extern)", nil, true},
+		{"(ref.extern 1)", hostReference(1), true},
+		{"(ref.extern 1)", hostReference(2), false},
+		{"(ref.func)", nil, false},
+		{"(ref.func)", hostReference(1), false},
+	}
+
+	for _, test := range tests {
+		exprs, err := parseSExpressions(test.result)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := parseScriptResult(exprs[0])
+		if assert.NoError(t, err, test.result) {
+			assert.Equal(t, test.expected, result.matches(test.actual), "%s matches %v", test.result, test.actual)
+		}
+	}
+}
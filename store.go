@@ -0,0 +1,458 @@
+package jwasm
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Store holds the function, table, memory and global instances of all module instances
+// that are linked with each other and the VM that executes their functions.
+// https://webassembly.github.io/spec/core/exec/runtime.html#store
+type Store struct {
+	functions []*function
+	tables    []*tableInstance
+	memories  []*memoryInstance
+	globals   []*globalInstance
+	// externs holds the host values passed as external references, a reference is the
+	// position of its value plus one so that 0 is the null reference. Positions that no
+	// table or global refers to anymore are collected into free for reuse between calls.
+	externs []any
+	free    []uint32
+	// collectAt is the number of externs at which unreferenced ones are collected next
+	collectAt int
+	// hosts holds the function instances of the host functions imported into the store
+	hosts map[*hostFunction]*function
+
+	vm *VM
+}
+
+// NewStore returns an empty store.
+func NewStore() *Store {
+	s := &Store{hosts: make(map[*hostFunction]*function)}
+	s.vm = &VM{store: s}
+	return s
+}
+
+// Instance is an instantiated module. Its index spaces refer to the instances of the store
+// that it was instantiated in, the imported ones may be shared with other instances.
+// https://webassembly.github.io/spec/core/exec/runtime.html#module-instances
+type Instance struct {
+	store     *Store
+	module    *Module
+	functions []*function
+	tables    []*tableInstance
+	memories  []*memoryInstance
+	globals   []*globalInstance
+	// elements and datas hold the contents of the element and data segments, which are
+	// nil once dropped
+	elements [][]uint64
+	datas    [][]byte
+	exports  map[string]exportDescription
+	// names are the debug names of the module for backtraces, nil if it has none
+	names *NameSection
+}
+
+// function is a function instance, the code of a function together with its type.
+// https://webassembly.github.io/spec/core/exec/runtime.html#function-instances
+type function struct {
+	// addr is the position of the function in its store, references to it are addr plus one
+	addr uint32
+	// instance is the module instance that defines the function as its function index,
+	// it is nil for host functions
+	instance     *Instance
+	index        functionIndex
+	functionType FunctionType
+	// code is nil for host functions
+	code *functionCode
+	host *hostFunction
+	// locals is the number of declared locals, which follow the parameters on the stack
+	locals int
+}
+
+// trapFrame returns the entry of f, which is not a host function, in the backtrace of a trap.
+func (f *function) trapFrame() TrapFrame {
+	names := f.instance.names
+	frame := TrapFrame{FunctionIndex: uint32(f.index), Name: names.FunctionName(uint32(f.index))}
+	if names != nil {
+		frame.Module = names.ModuleName
+	}
+	return frame
+}
+
+// https://webassembly.github.io/spec/core/exec/runtime.html#global-instances
+type globalInstance struct {
+	globalType GlobalType
+	value      uint64
+}
+
+func (s *Store) allocateFunction(f *function) *function {
+	f.addr = uint32(len(s.functions))
+	s.functions = append(s.functions, f)
+	return f
+}
+
+// allocateHostFunction returns the function instance of h, which is allocated once per store.
+// https://webassembly.github.io/spec/core/exec/modules.html#alloc-hostfunc
+func (s *Store) allocateHostFunction(h *hostFunction) *function {
+	if f, ok := s.hosts[h]; ok {
+		return f
+	}
+
+	f := s.allocateFunction(&function{functionType: h.functionType, host: h})
+	s.hosts[h] = f
+	return f
+}
+
+// instantiate instantiates the validated module m, imports holds the function, table,
+// memory and global instances that its imports resolve to in the order of the imports.
+// The functions, tables, memories and globals of m are allocated before its tables and
+// memories are initialized with the active element and data segments and its start
+// function runs. Instances that are modified before a segment traps stay modified.
+// https://webassembly.github.io/spec/core/exec/modules.html#instantiation
+func (s *Store) instantiate(ctx context.Context, m *Module, imports []any) (inst *Instance, err error) {
+	inst = &Instance{store: s, module: m, exports: make(map[string]exportDescription), names: m.Names()}
+
+	for _, imported := range imports {
+		switch imported := imported.(type) {
+		case *function:
+			inst.functions = append(inst.functions, imported)
+		case *tableInstance:
+			inst.tables = append(inst.tables, imported)
+		case *memoryInstance:
+			inst.memories = append(inst.memories, imported)
+		case *globalInstance:
+			inst.globals = append(inst.globals, imported)
+		}
+	}
+
+	// Constant expressions and segments are evaluated in the context of the new instance
+	vm := s.vm
+	caller, outer, height := vm.instance, vm.ctx, len(vm.stack)
+	vm.instance, vm.ctx = inst, ctx
+	defer func() {
+		if r := recover(); r != nil {
+			inst, err = nil, &Trap{Code: TrapPanic, Err: &panicError{r}}
+		}
+		vm.instance, vm.ctx = caller, outer
+		if err != nil {
+			vm.stack = vm.stack[:height]
+		}
+	}()
+
+	if m.CodeSection != nil {
+		for i := range m.CodeSection.functionCode {
+			code := &m.CodeSection.functionCode[i]
+			idx := functionIndex(len(inst.functions))
+			functionType, err := m.functionType(idx)
+			if err != nil {
+				return nil, fmt.Errorf("instantiating function [%d] failed: %w", idx, err)
+			}
+
+			locals := 0
+			for _, run := range code.locals {
+				locals += int(run.n)
+			}
+
+			f := s.allocateFunction(&function{instance: inst, index: idx, functionType: functionType, code: code, locals: locals})
+			inst.functions = append(inst.functions, f)
+		}
+	}
+
+	if m.TableSection != nil {
+		for _, tableType := range m.TableSection.tables {
+			if tableType.Limits.Min > maxTableSize {
+				return nil, fmt.Errorf("allocating table [%d] failed: more than [%d] elements", len(inst.tables), maxTableSize)
+			}
+
+			table := newTableInstance(tableType)
+			s.tables = append(s.tables, table)
+			inst.tables = append(inst.tables, table)
+		}
+	}
+
+	if m.MemorySection != nil {
+		for _, memoryType := range m.MemorySection.memories {
+			if memoryType.Limits.Min > maxMemorySize {
+				return nil, fmt.Errorf("allocating memory [%d] failed: more than [%d] pages", len(inst.memories), maxMemorySize)
+			}
+
+			memory := newMemoryInstance(memoryType)
+			s.memories = append(s.memories, memory)
+			inst.memories = append(inst.memories, memory)
+		}
+	}
+
+	if m.GlobalSection != nil {
+		for i, global := range m.GlobalSection.globals {
+			value, err := vm.evaluate(global.init)
+			if err != nil {
+				return nil, fmt.Errorf("initializing global [%d] failed: %w", i, err)
+			}
+
+			g := &globalInstance{global.globalType, value}
+			s.globals = append(s.globals, g)
+			inst.globals = append(inst.globals, g)
+		}
+	}
+
+	if m.ExportSection != nil {
+		for _, export := range m.ExportSection.exports {
+			inst.exports[export.name] = export.exportDescription
+		}
+	}
+
+	if m.ElementSection != nil {
+		for i, element := range m.ElementSection.elements {
+			var refs []uint64
+			for _, x := range element.functionIndices {
+				refs = append(refs, uint64(inst.functions[x].addr)+1)
+			}
+			for _, init := range element.init {
+				ref, err := vm.evaluate(init)
+				if err != nil {
+					return nil, fmt.Errorf("initializing element segment [%d] failed: %w", i, err)
+				}
+				refs = append(refs, ref)
+			}
+			inst.elements = append(inst.elements, refs)
+		}
+
+		// Active segments are copied into tables and dropped, as if by table.init and
+		// elem.drop, declarative segments are only dropped
+		for i, element := range m.ElementSection.elements {
+			switch mode := element.mode.(type) {
+			case *elementModeActive:
+				offset, err := vm.evaluate(mode.offset)
+				if err != nil {
+					return nil, fmt.Errorf("initializing element segment [%d] failed: %w", i, err)
+				}
+
+				vm.stack = append(vm.stack, offset, 0, uint64(len(inst.elements[i])))
+				if err := vm.tableInit(mode.table, elementIndex(i)); err != nil {
+					return nil, fmt.Errorf("initializing element segment [%d] failed: %w", i, newTrap(err))
+				}
+				inst.elements[i] = nil
+			case *elementModeDeclarative:
+				inst.elements[i] = nil
+			}
+		}
+	}
+
+	if m.DataSection != nil {
+		for _, data := range m.DataSection.data {
+			inst.datas = append(inst.datas, data.init)
+		}
+
+		// Active segments are copied into memory and dropped, as if by memory.init and data.drop
+		for i, data := range m.DataSection.data {
+			mode, ok := data.mode.(*dataModeActive)
+			if !ok {
+				continue
+			}
+
+			offset, err := vm.evaluate(mode.offset)
+			if err != nil {
+				return nil, fmt.Errorf("initializing data segment [%d] failed: %w", i, err)
+			}
+
+			vm.stack = append(vm.stack, offset, 0, uint64(len(data.init)))
+			if err := vm.memoryInit(dataIndex(i)); err != nil {
+				return nil, fmt.Errorf("initializing data segment [%d] failed: %w", i, newTrap(err))
+			}
+			inst.datas[i] = nil
+		}
+	}
+
+	if m.StartSection != nil {
+		if err := vm.invoke(inst.functions[m.StartSection.start]); err != nil {
+			return nil, fmt.Errorf("running start function failed: %w", err)
+		}
+	}
+
+	return inst, nil
+}
+
+// extern returns the function, table, memory or global instance exported as name.
+// https://webassembly.github.io/spec/core/exec/runtime.html#external-values
+func (inst *Instance) extern(name string) (any, bool) {
+	switch desc := inst.exports[name].(type) {
+	case *exportDescriptionFunc:
+		return inst.functions[desc.functionIndex], true
+	case *exportDescriptionTable:
+		return inst.tables[desc.tableIndex], true
+	case *exportDescriptionMem:
+		return inst.memories[desc.memoryIndex], true
+	case *exportDescriptionGlobal:
+		return inst.globals[desc.globalIndex], true
+	default:
+		return nil, false
+	}
+}
+
+// Call invokes the exported function name with args and returns its results. Values of
+// type i32, i64, f32 and f64 are passed as int32, int64, float32 and float64, null references
+// as nil and other external references as any Go value. Function references are opaque.
+func (inst *Instance) Call(name string, args ...any) ([]any, error) {
+	return inst.CallContext(context.Background(), name, args...)
+}
+
+// CallContext is like Call, ctx is passed to the host functions that the call invokes.
+func (inst *Instance) CallContext(ctx context.Context, name string, args ...any) ([]any, error) {
+	desc, ok := inst.exports[name].(*exportDescriptionFunc)
+	if !ok {
+		return nil, fmt.Errorf("calling [%s] failed: no exported function with this name", name)
+	}
+
+	s, vm := inst.store, inst.store.vm
+	f := inst.functions[desc.functionIndex]
+	parameters := f.functionType.ParameterTypes
+	if len(args) != len(parameters) {
+		return nil, fmt.Errorf("calling [%s] failed: expected %d arguments, got %d", name, len(parameters), len(args))
+	}
+
+	// Unreferenced external references are collected before calls from the host only, the
+	// stack of reentrant calls holds references of the outer call
+	if len(vm.stack) == 0 && len(s.free) == 0 && len(s.externs) >= s.collectAt {
+		s.collectExterns()
+	}
+
+	height := len(vm.stack)
+	for i, arg := range args {
+		value, err := s.toValue(parameters[i], arg)
+		if err != nil {
+			vm.stack = vm.stack[:height]
+			return nil, fmt.Errorf("calling [%s] failed: argument [%d]: %w", name, i, err)
+		}
+		vm.push(value)
+	}
+
+	// Host functions may call back into the store, the context of the outer call is restored
+	// once the inner one returns
+	outer := vm.ctx
+	vm.ctx = ctx
+	err := vm.invoke(f)
+	vm.ctx = outer
+	if err != nil {
+		return nil, fmt.Errorf("calling [%s] failed: %w", name, err)
+	}
+
+	results := make([]any, len(f.functionType.ResultTypes))
+	for i, t := range f.functionType.ResultTypes {
+		results[i] = s.fromValue(t, vm.stack[height+i])
+	}
+	vm.stack = vm.stack[:height]
+
+	return results, nil
+}
+
+// Global returns the value of the exported global name.
+func (inst *Instance) Global(name string) (any, error) {
+	desc, ok := inst.exports[name].(*exportDescriptionGlobal)
+	if !ok {
+		return nil, fmt.Errorf("reading global [%s] failed: no exported global with this name", name)
+	}
+
+	global := inst.globals[desc.globalIndex]
+	return inst.store.fromValue(global.globalType.ValueType, global.value), nil
+}
+
+func (s *Store) toValue(t ValueType, v any) (uint64, error) {
+	switch t {
+	case ValueTypeI32:
+		if n, ok := v.(int32); ok {
+			return uint64(uint32(n)), nil
+		}
+	case ValueTypeI64:
+		if n, ok := v.(int64); ok {
+			return uint64(n), nil
+		}
+	case ValueTypeF32:
+		if z, ok := v.(float32); ok {
+			return uint64(math.Float32bits(z)), nil
+		}
+	case ValueTypeF64:
+		if z, ok := v.(float64); ok {
+			return math.Float64bits(z), nil
+		}
+	case ValueTypeFuncRef:
+		if v == nil {
+			return 0, nil
+		}
+		if f, ok := v.(*function); ok && int(f.addr) < len(s.functions) && s.functions[f.addr] == f {
+			return uint64(f.addr) + 1, nil
+		}
+	case ValueTypeExternRef:
+		if v == nil {
+			return 0, nil
+		}
+		if n := len(s.free); n > 0 {
+			i := s.free[n-1]
+			s.free = s.free[:n-1]
+			s.externs[i] = v
+			return uint64(i) + 1, nil
+		}
+
+		s.externs = append(s.externs, v)
+		return uint64(len(s.externs)), nil
+	}
+
+	return 0, fmt.Errorf("%w: expected %s, got %T", ErrTypeMismatch, t, v)
+}
+
+// collectExterns frees the external references that no table or global refers to. It may
+// only run while no call is active, since the values on the stack are untyped.
+func (s *Store) collectExterns() {
+	live := make([]bool, len(s.externs))
+	for _, t := range s.tables {
+		if t.tableType.ElementType != ValueTypeExternRef {
+			continue
+		}
+		for _, ref := range t.elements {
+			if ref != 0 {
+				live[ref-1] = true
+			}
+		}
+	}
+	for _, g := range s.globals {
+		if g.globalType.ValueType == ValueTypeExternRef && g.value != 0 {
+			live[g.value-1] = true
+		}
+	}
+
+	s.free = s.free[:0]
+	for i := range s.externs {
+		if !live[i] {
+			s.externs[i] = nil
+			s.free = append(s.free, uint32(i))
+		}
+	}
+
+	// Collecting again once the live references doubled keeps the cost amortized
+	s.collectAt = 2*(len(s.externs)-len(s.free)) + 1024
+}
+
+func (s *Store) fromValue(t ValueType, value uint64) any {
+	switch t {
+	case ValueTypeI32:
+		return int32(uint32(value))
+	case ValueTypeI64:
+		return int64(value)
+	case ValueTypeF32:
+		return math.Float32frombits(uint32(value))
+	case ValueTypeF64:
+		return math.Float64frombits(value)
+	case ValueTypeFuncRef:
+		if value == 0 {
+			return nil
+		}
+		return s.functions[value-1]
+	case ValueTypeExternRef:
+		if value == 0 {
+			return nil
+		}
+		return s.externs[value-1]
+	default:
+		return nil
+	}
+}
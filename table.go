@@ -0,0 +1,164 @@
+package jwasm
+
+import (
+	"slices"
+)
+
+// tableInstance is a vector of references, which are kept as they are on the stack.
+// https://webassembly.github.io/spec/core/exec/runtime.html#table-instances
+type tableInstance struct {
+	tableType TableType
+	elements  []uint64
+}
+
+func newTableInstance(tableType TableType) *tableInstance {
+	return &tableInstance{tableType, make([]uint64, tableType.Limits.Min)}
+}
+
+// maxTableSize limits the number of elements of a table, which the limits of table types
+// allow up to 2^32-1 of.
+const maxTableSize = 10000000
+
+// grow adds n elements with the value init to the table and returns its previous size. It
+// fails if the table would exceed its maximum or maxTableSize.
+// https://webassembly.github.io/spec/core/exec/modules.html#grow-table
+func (t *tableInstance) grow(n uint32, init uint64) (uint32, bool) {
+	previous := uint32(len(t.elements))
+
+	max := uint64(maxTableSize)
+	if t.tableType.Limits.Max != nil && uint64(*t.tableType.Limits.Max) < max {
+		max = uint64(*t.tableType.Limits.Max)
+	}
+
+	if uint64(previous)+uint64(n) > max {
+		return previous, false
+	}
+
+	t.elements = slices.Grow(t.elements, int(n))[:previous+n]
+	for i := previous; i < previous+n; i++ {
+		t.elements[i] = init
+	}
+	return previous, true
+}
+
+// slice returns the n elements at index i, or ErrOutOfBoundsTableAccess if they are not
+// all inside of the table.
+func (t *tableInstance) slice(i uint64, n uint64) ([]uint64, error) {
+	if i+n > uint64(len(t.elements)) {
+		return nil, ErrOutOfBoundsTableAccess
+	}
+	return t.elements[i : i+n], nil
+}
+
+// Table Instructions
+// https://webassembly.github.io/spec/core/exec/instructions.html#table-instructions
+
+func (vm *VM) tableGet(x tableIndex) error {
+	top := len(vm.stack) - 1
+
+	elements, err := vm.instance.tables[x].slice(uint64(uint32(vm.stack[top])), 1)
+	if err != nil {
+		return err
+	}
+
+	vm.stack[top] = elements[0]
+	return nil
+}
+
+func (vm *VM) tableSet(x tableIndex) error {
+	value, i := vm.pop(), uint64(uint32(vm.pop()))
+
+	elements, err := vm.instance.tables[x].slice(i, 1)
+	if err != nil {
+		return err
+	}
+
+	elements[0] = value
+	return nil
+}
+
+// tableGrow executes table.grow, which pushes -1 if the table cannot grow.
+func (vm *VM) tableGrow(x tableIndex) {
+	n := uint32(vm.pop())
+	top := len(vm.stack) - 1
+
+	previous, ok := vm.instance.tables[x].grow(n, vm.stack[top])
+	if !ok {
+		previous = 0xFFFFFFFF
+	}
+	vm.stack[top] = uint64(previous)
+}
+
+// tableFill executes table.fill, which sets n elements at i to the value val.
+func (vm *VM) tableFill(x tableIndex) error {
+	n, val, i := uint64(uint32(vm.pop())), vm.pop(), uint64(uint32(vm.pop()))
+
+	elements, err := vm.instance.tables[x].slice(i, n)
+	if err != nil {
+		return err
+	}
+
+	for j := range elements {
+		elements[j] = val
+	}
+	return nil
+}
+
+// tableCopy executes table.copy, which copies n elements from s in table y to d in table x.
+func (vm *VM) tableCopy(x tableIndex, y tableIndex) error {
+	n, s, d := uint64(uint32(vm.pop())), uint64(uint32(vm.pop())), uint64(uint32(vm.pop()))
+
+	src, err := vm.instance.tables[y].slice(s, n)
+	if err != nil {
+		return err
+	}
+
+	dst, err := vm.instance.tables[x].slice(d, n)
+	if err != nil {
+		return err
+	}
+
+	copy(dst, src)
+	return nil
+}
+
+// tableInit executes table.init, which copies n elements at s of the element segment y to
+// d in table x. Dropped segments are empty.
+func (vm *VM) tableInit(x tableIndex, y elementIndex) error {
+	n, s, d := uint64(uint32(vm.pop())), uint64(uint32(vm.pop())), uint64(uint32(vm.pop()))
+
+	element := vm.instance.elements[y]
+	if s+n > uint64(len(element)) {
+		return ErrOutOfBoundsTableAccess
+	}
+
+	dst, err := vm.instance.tables[x].slice(d, n)
+	if err != nil {
+		return err
+	}
+
+	copy(dst, element[s:s+n])
+	return nil
+}
+
+// callIndirect executes call_indirect, which calls the function at the index on top of the
+// stack in table x if its type equals the type y.
+// https://webassembly.github.io/spec/core/exec/instructions.html#xref-syntax-instructions-syntax-instr-control-mathsf-call-indirect-x-y
+func (vm *VM) callIndirect(x tableIndex, y typeIndex) error {
+	i := uint32(vm.pop())
+
+	elements := vm.instance.tables[x].elements
+	switch {
+	case uint64(i) >= uint64(len(elements)):
+		return ErrUndefinedElement
+	case elements[i] == 0:
+		return ErrUninitializedElement
+	}
+
+	f := vm.store.functions[elements[i]-1]
+	if !equalFunctionTypes(f.functionType, vm.instance.module.TypeSection.FunctionTypes[y]) {
+		return ErrIndirectCallTypeMismatch
+	}
+
+	return vm.call(f)
+}
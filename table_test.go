@@ -0,0 +1,43 @@
+package jwasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrowingTables(t *testing.T) {
+	table := newTableInstance(TableType{ValueTypeFuncRef, Limits{1, ptr(uint32(3))}})
+
+	previous, ok := table.grow(2, 5)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), previous)
+	assert.Equal(t, []uint64{0, 5, 5}, table.elements)
+
+	_, ok = table.grow(1, 0)
+	assert.False(t, ok)
+
+	_, err := table.slice(2, 2)
+	assert.ErrorIs(t, err, ErrOutOfBoundsTableAccess)
+
+	unbounded := newTableInstance(TableType{ValueTypeFuncRef, Limits{0, nil}})
+	_, ok = unbounded.grow(0xFFFFFFFF, 0)
+	assert.False(t, ok)
+	assert.Empty(t, unbounded.elements)
+}
+
+func TestInstantiatingLimitsTableSize(t *testing.T) {
+	_, err := (&Interpreter{}).Instantiate(assemble(t, `(table 0xFFFFFFFF funcref)`))
+	assert.ErrorContains(t, err, "allocating table [0] failed")
+}
+
+func TestCallingIndirectlyChecksTypes(t *testing.T) {
+	inst := instantiate(t, `(module
+  (type $v (func))
+  (table funcref (elem $f))
+  (func $f (param i32))
+  (func (export "call") (call_indirect (type $v) (i32.const 0))))`)
+
+	_, err := inst.Call("call")
+	assert.ErrorIs(t, err, ErrIndirectCallTypeMismatch)
+}
@@ -0,0 +1,34 @@
+;; Decoding of modules given in the binary format
+
+(module binary "\00asm" "\01\00\00\00")
+(module binary
+  "\00asm" "\01\00\00\00"
+  "\01\05\01"                    ;; type section with one type
+  "\60\00\01\7f"                 ;; (func (result i32))
+  "\03\02\01\00"                 ;; function section
+  "\07\05\01\01f\00\00"          ;; export "f"
+  "\0a\06\01\04\00\41\2a\0b"     ;; code: i32.const 42
+)
+(assert_return (invoke "f") (i32.const 42))
+
+(assert_malformed (module binary "") "unexpected end")
+(assert_malformed (module binary "\00asm") "unexpected end")
+(assert_malformed (module binary "asm\00" "\01\00\00\00") "magic header not detected")
+(assert_malformed (module binary "\00asm" "\02\00\00\00") "unknown binary version")
+(assert_malformed (module binary "\00asm" "\01\00\00\00" "\0e\01\00") "malformed section id")
+(assert_malformed
+  (module binary
+    "\00asm" "\01\00\00\00"
+    "\03\02\01\00"               ;; function section
+    "\01\04\01\60\00\00"         ;; type section after function section
+  )
+  "unexpected section"
+)
+(assert_malformed
+  (module binary
+    "\00asm" "\01\00\00\00"
+    "\05\08\01"                  ;; memory section with one memory
+    "\00\82\80\80\80\80\00"      ;; limits with an overlong minimum
+  )
+  "integer representation too long"
+)
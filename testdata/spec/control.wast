@@ -0,0 +1,101 @@
+;; Execution of structured control flow, calls and variables
+
+(module
+  (type $pair (func (param i32 i32) (result i32)))
+  (type $step (func (param i32) (result i32)))
+  (global $counter (mut i32) (i32.const 0))
+
+  (func $fac (export "fac") (param i64) (result i64)
+    (if (result i64) (i64.eqz (local.get 0))
+      (then (i64.const 1))
+      (else (i64.mul (local.get 0) (call $fac (i64.sub (local.get 0) (i64.const 1)))))))
+
+  (func (export "fac-iter") (param $n i64) (result i64)
+    (local $acc i64)
+    (local.set $acc (i64.const 1))
+    (block $done
+      (loop $next
+        (br_if $done (i64.eqz (local.get $n)))
+        (local.set $acc (i64.mul (local.get $acc) (local.get $n)))
+        (local.set $n (i64.sub (local.get $n) (i64.const 1)))
+        (br $next)))
+    (local.get $acc))
+
+  (func (export "switch") (param i32) (result i32)
+    (block $default
+      (block $two
+        (block $one
+          (block $zero
+            (br_table $zero $one $two $default (local.get 0)))
+          (return (i32.const 10)))
+        (return (i32.const 11)))
+      (return (i32.const 12)))
+    (i32.const 100))
+
+  (func (export "nested-br") (result i32)
+    (i32.add
+      (i32.const 1)
+      (block (result i32)
+        (drop (block (result i32) (br 1 (i32.const 2))))
+        (i32.const 3))))
+
+  (func (export "block-params") (result i32)
+    (i32.const 1) (i32.const 2)
+    (block (type $pair) (i32.add)))
+
+  (func (export "loop-params") (param $n i32) (result i32)
+    (i32.const 0)
+    (loop (type $step)
+      (i32.add (local.get $n))
+      (br_if 0 (local.tee $n (i32.sub (local.get $n) (i32.const 1))))))
+
+  (func (export "select") (param i32) (result i64)
+    (select (i64.const 1) (i64.const 2) (local.get 0)))
+
+  (func (export "count") (result i32)
+    (global.set $counter (i32.add (global.get $counter) (i32.const 1)))
+    (global.get $counter))
+
+  (func (export "early-return") (param i32) (result i32)
+    (if (local.get 0) (then (return (i32.const 1))))
+    (i32.const 0))
+
+  (func (export "multi") (result i32 i64)
+    (i32.const 1) (i64.const 2))
+
+  (func (export "unreachable") (unreachable))
+
+  (func $runaway (export "runaway") (call $runaway))
+)
+
+(assert_return (invoke "fac" (i64.const 20)) (i64.const 2432902008176640000))
+(assert_return (invoke "fac-iter" (i64.const 20)) (i64.const 2432902008176640000))
+(assert_return (invoke "switch" (i32.const 0)) (i32.const 10))
+(assert_return (invoke "switch" (i32.const 1)) (i32.const 11))
+(assert_return (invoke "switch" (i32.const 2)) (i32.const 12))
+(assert_return (invoke "switch" (i32.const 3)) (i32.const 100))
+(assert_return (invoke "switch" (i32.const -1)) (i32.const 100))
+(assert_return (invoke "nested-br") (i32.const 3))
+(assert_return (invoke "block-params") (i32.const 3))
+(assert_return (invoke "loop-params" (i32.const 4)) (i32.const 10))
+(assert_return (invoke "select" (i32.const 1)) (i64.const 1))
+(assert_return (invoke "select" (i32.const 0)) (i64.const 2))
+(assert_return (invoke "count") (i32.const 1))
+(assert_return (invoke "count") (i32.const 2))
+(assert_return (invoke "early-return" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "early-return" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "multi") (i32.const 1) (i64.const 2))
+(assert_trap (invoke "unreachable") "unreachable")
+(assert_exhaustion (invoke "runaway") "call stack exhausted")
+(assert_return (invoke "fac" (i64.const 3)) (i64.const 6))
+
+(module
+  (global $g (mut i32) (i32.const 0))
+  (func $init (global.set $g (i32.const 42)))
+  (func (export "g") (result i32) (global.get $g))
+  (start $init))
+
+(assert_return (invoke "g") (i32.const 42))
+
+(assert_trap (module (func $start unreachable) (start $start)) "unreachable")
+(assert_uninstantiable (module (func $start unreachable) (start $start)) "unreachable")
@@ -0,0 +1,14 @@
+;; Linking of imported host functions
+
+(module
+  (import "spectest" "print_i32" (func $print (param i32)))
+  (import "spectest" "print_f64_f64" (func (param f64 f64)))
+  (func (export "print") (param i32) (result i32)
+    (call $print (local.get 0))
+    (local.get 0)))
+
+(assert_return (invoke "print" (i32.const 7)) (i32.const 7))
+
+(assert_unlinkable (module (import "spectest" "unknown" (func))) "unknown import")
+(assert_unlinkable (module (import "spectest" "print_i32" (func (param i64)))) "incompatible import type")
+(assert_unlinkable (module (import "spectest" "print_i32" (func (result i32)))) "incompatible import type")
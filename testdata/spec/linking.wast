@@ -0,0 +1,106 @@
+;; Linking of modules with each other and with the spectest module
+
+(module $Mf
+  (func (export "call") (result i32) (call $g))
+  (func $g (result i32) (i32.const 2)))
+(register "Mf" $Mf)
+
+(module $Nf
+  (func $f (import "Mf" "call") (result i32))
+  (export "Mf.call" (func $f))
+  (func (export "call Mf.call") (result i32) (call $f)))
+
+(assert_return (invoke $Mf "call") (i32.const 2))
+(assert_return (invoke $Nf "Mf.call") (i32.const 2))
+(assert_return (invoke $Nf "call Mf.call") (i32.const 2))
+
+(module $Mg
+  (global $glob (export "glob") i32 (i32.const 42))
+  (global $mut_glob (export "mut_glob") (mut i32) (i32.const 142))
+  (func (export "get_mut") (result i32) (global.get $mut_glob))
+  (func (export "set_mut") (param i32) (global.set $mut_glob (local.get 0))))
+(register "Mg" $Mg)
+
+(module $Ng
+  (global $x (import "Mg" "glob") i32)
+  (global $mut_glob (import "Mg" "mut_glob") (mut i32))
+  (func (export "Mg.get_mut") (result i32) (global.get $mut_glob))
+  (func (export "Mg.set_mut") (param i32) (global.set $mut_glob (local.get 0)))
+  (func (export "get") (result i32) (global.get $x)))
+
+(assert_return (invoke $Ng "get") (i32.const 42))
+(assert_return (invoke $Mg "get_mut") (i32.const 142))
+(invoke $Ng "Mg.set_mut" (i32.const 241))
+(assert_return (invoke $Mg "get_mut") (i32.const 241))
+(assert_return (invoke $Ng "Mg.get_mut") (i32.const 241))
+(assert_return (get $Mg "mut_glob") (i32.const 241))
+
+(assert_unlinkable (module (import "Mg" "mut_glob" (global i32))) "incompatible import type")
+(assert_unlinkable (module (import "Mg" "glob" (global (mut i32)))) "incompatible import type")
+(assert_unlinkable (module (import "Mg" "glob" (func))) "incompatible import type")
+
+(module $Mt
+  (type (func (result i32)))
+  (table (export "tab") 10 funcref)
+  (elem (i32.const 2) $g $g $g $g)
+  (func $g (result i32) (i32.const 4))
+  (func (export "call") (param i32) (result i32)
+    (call_indirect (type 0) (local.get 0))))
+(register "Mt" $Mt)
+
+(module $Nt
+  (type (func (result i32)))
+  (table (import "Mt" "tab") 10 funcref)
+  (func $f (result i32) (i32.const 5))
+  (elem (i32.const 1) $f)
+  (func (export "call") (param i32) (result i32)
+    (call_indirect (type 0) (local.get 0))))
+
+;; Functions keep running in the instance that defines them when called through a table
+(assert_return (invoke $Mt "call" (i32.const 1)) (i32.const 5))
+(assert_return (invoke $Mt "call" (i32.const 2)) (i32.const 4))
+(assert_return (invoke $Nt "call" (i32.const 1)) (i32.const 5))
+(assert_return (invoke $Nt "call" (i32.const 3)) (i32.const 4))
+(assert_trap (invoke $Nt "call" (i32.const 7)) "uninitialized element")
+
+(assert_unlinkable (module (import "Mt" "tab" (table 11 funcref))) "incompatible import type")
+(assert_unlinkable (module (import "Mt" "tab" (table 10 20 funcref))) "incompatible import type")
+
+;; Segments that trap leave the tables they initialized before modified
+(assert_trap
+  (module
+    (table (import "Mt" "tab") 10 funcref)
+    (func $h (result i32) (i32.const 6))
+    (elem (i32.const 7) $h)
+    (elem (i32.const 9) $h $h))
+  "out of bounds table access")
+(assert_return (invoke $Mt "call" (i32.const 7)) (i32.const 6))
+
+(module $Mm
+  (memory (export "mem") 1 5)
+  (data (i32.const 10) "\00\01\02\03\04\05\06\07\08\09")
+  (func (export "load") (param i32) (result i32) (i32.load8_u (local.get 0))))
+(register "Mm" $Mm)
+
+(module $Nm
+  (memory (import "Mm" "mem") 1)
+  (data (i32.const 12) "\a7")
+  (func (export "load") (param i32) (result i32) (i32.load8_u (local.get 0)))
+  (func (export "grow") (param i32) (result i32) (memory.grow (local.get 0))))
+
+(assert_return (invoke $Mm "load" (i32.const 12)) (i32.const 0xa7))
+(assert_return (invoke $Nm "load" (i32.const 11)) (i32.const 1))
+(assert_return (invoke $Nm "grow" (i32.const 2)) (i32.const 1))
+(assert_unlinkable (module (import "Mm" "mem" (memory 4))) "incompatible import type")
+(assert_unlinkable (module (import "Mm" "mem" (memory 1 4))) "incompatible import type")
+
+;; The spectest module
+(module
+  (import "spectest" "global_i32" (global $g i32))
+  (import "spectest" "table" (table 10 20 funcref))
+  (import "spectest" "memory" (memory 1 2))
+  (func (export "global") (result i32) (global.get $g))
+  (func (export "size") (result i32) (i32.add (table.size) (memory.size))))
+
+(assert_return (invoke "global") (i32.const 666))
+(assert_return (invoke "size") (i32.const 11))
@@ -0,0 +1,84 @@
+;; Execution of loads, stores and instructions that operate on whole memories
+
+(module
+  (memory 1 3)
+  (data (i32.const 0) "\01\02\03\04\05\06\07\08\80\ff")
+  (data $passive "abc")
+
+  (func (export "i32.load") (param i32) (result i32) (i32.load (local.get 0)))
+  (func (export "i32.load_offset") (param i32) (result i32) (i32.load offset=4 (local.get 0)))
+  (func (export "i32.load8_s") (param i32) (result i32) (i32.load8_s (local.get 0)))
+  (func (export "i32.load8_u") (param i32) (result i32) (i32.load8_u (local.get 0)))
+  (func (export "i32.load16_s") (param i32) (result i32) (i32.load16_s (local.get 0)))
+  (func (export "i64.load") (param i32) (result i64) (i64.load (local.get 0)))
+  (func (export "i64.load32_s") (param i32) (result i64) (i64.load32_s (local.get 0)))
+  (func (export "i64.load32_u") (param i32) (result i64) (i64.load32_u (local.get 0)))
+  (func (export "f32.load") (param i32) (result f32) (f32.load (local.get 0)))
+
+  (func (export "i32.store8") (param i32 i32) (i32.store8 (local.get 0) (local.get 1)))
+  (func (export "i64.store16") (param i32 i64) (i64.store16 (local.get 0) (local.get 1)))
+  (func (export "f64.store") (param i32 f64) (f64.store (local.get 0) (local.get 1)))
+  (func (export "f64.load") (param i32) (result f64) (f64.load (local.get 0)))
+
+  (func (export "size") (result i32) (memory.size))
+  (func (export "grow") (param i32) (result i32) (memory.grow (local.get 0)))
+
+  (func (export "fill") (param i32 i32 i32) (memory.fill (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "copy") (param i32 i32 i32) (memory.copy (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "init") (param i32 i32 i32) (memory.init $passive (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "drop") (data.drop $passive))
+)
+
+(assert_return (invoke "i32.load" (i32.const 0)) (i32.const 0x04030201))
+(assert_return (invoke "i32.load_offset" (i32.const 0)) (i32.const 0x08070605))
+(assert_return (invoke "i32.load8_s" (i32.const 8)) (i32.const -128))
+(assert_return (invoke "i32.load8_u" (i32.const 8)) (i32.const 128))
+(assert_return (invoke "i32.load16_s" (i32.const 8)) (i32.const -128))
+(assert_return (invoke "i64.load" (i32.const 0)) (i64.const 0x0807060504030201))
+(assert_return (invoke "i64.load32_s" (i32.const 6)) (i64.const 0xffffffffff800807))
+(assert_return (invoke "i64.load32_u" (i32.const 6)) (i64.const 0xff800807))
+(assert_return (invoke "f32.load" (i32.const 100)) (f32.const 0))
+
+(assert_return (invoke "i32.store8" (i32.const 100) (i32.const 0x1ff)))
+(assert_return (invoke "i32.load" (i32.const 100)) (i32.const 0xff))
+(assert_return (invoke "i64.store16" (i32.const 100) (i64.const -2)))
+(assert_return (invoke "i32.load" (i32.const 100)) (i32.const 0xfffe))
+(assert_return (invoke "f64.store" (i32.const 200) (f64.const -1.5)))
+(assert_return (invoke "f64.load" (i32.const 200)) (f64.const -1.5))
+
+(assert_return (invoke "i32.load" (i32.const 65532)) (i32.const 0))
+(assert_trap (invoke "i32.load" (i32.const 65533)) "out of bounds memory access")
+(assert_trap (invoke "i32.load" (i32.const -1)) "out of bounds memory access")
+(assert_trap (invoke "i32.load_offset" (i32.const 65530)) "out of bounds memory access")
+(assert_trap (invoke "i32.store8" (i32.const 65536) (i32.const 0)) "out of bounds memory access")
+
+(assert_return (invoke "size") (i32.const 1))
+(assert_return (invoke "grow" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "size") (i32.const 2))
+(assert_return (invoke "i32.load" (i32.const 65536)) (i32.const 0))
+(assert_return (invoke "grow" (i32.const 2)) (i32.const -1))
+(assert_return (invoke "grow" (i32.const 1)) (i32.const 2))
+(assert_return (invoke "grow" (i32.const 0)) (i32.const 3))
+(assert_return (invoke "grow" (i32.const 1)) (i32.const -1))
+
+(assert_return (invoke "fill" (i32.const 300) (i32.const 0xaa) (i32.const 4)))
+(assert_return (invoke "i32.load" (i32.const 300)) (i32.const 0xaaaaaaaa))
+(assert_trap (invoke "fill" (i32.const 196607) (i32.const 0) (i32.const 2)) "out of bounds memory access")
+(assert_return (invoke "copy" (i32.const 1) (i32.const 0) (i32.const 4)))
+(assert_return (invoke "i32.load" (i32.const 0)) (i32.const 0x03020101))
+(assert_return (invoke "init" (i32.const 400) (i32.const 1) (i32.const 2)))
+(assert_return (invoke "i32.load" (i32.const 400)) (i32.const 0x6362))
+(assert_trap (invoke "init" (i32.const 400) (i32.const 2) (i32.const 2)) "out of bounds memory access")
+(assert_return (invoke "drop"))
+(assert_return (invoke "init" (i32.const 400) (i32.const 0) (i32.const 0)))
+(assert_trap (invoke "init" (i32.const 400) (i32.const 0) (i32.const 1)) "out of bounds memory access")
+
+(module
+  (memory 0)
+  (func (export "grow") (param i32) (result i32) (memory.grow (local.get 0)))
+  (func (export "size") (result i32) (memory.size)))
+
+(assert_return (invoke "size") (i32.const 0))
+(assert_return (invoke "grow" (i32.const 0x10001)) (i32.const -1))
+
+(assert_trap (module (memory 1) (data (i32.const 65535) "ab")) "out of bounds memory access")
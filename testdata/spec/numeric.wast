@@ -0,0 +1,100 @@
+;; Execution of numeric instructions at the edges of their domains
+
+(module
+  (func (export "i32.div_s") (param i32 i32) (result i32) (i32.div_s (local.get 0) (local.get 1)))
+  (func (export "i32.div_u") (param i32 i32) (result i32) (i32.div_u (local.get 0) (local.get 1)))
+  (func (export "i32.rem_s") (param i32 i32) (result i32) (i32.rem_s (local.get 0) (local.get 1)))
+  (func (export "i32.shr_s") (param i32 i32) (result i32) (i32.shr_s (local.get 0) (local.get 1)))
+  (func (export "i32.rotr") (param i32 i32) (result i32) (i32.rotr (local.get 0) (local.get 1)))
+  (func (export "i32.clz") (param i32) (result i32) (i32.clz (local.get 0)))
+  (func (export "i32.lt_u") (param i32 i32) (result i32) (i32.lt_u (local.get 0) (local.get 1)))
+  (func (export "i32.extend8_s") (param i32) (result i32) (i32.extend8_s (local.get 0)))
+  (func (export "i64.div_s") (param i64 i64) (result i64) (i64.div_s (local.get 0) (local.get 1)))
+  (func (export "i64.rem_s") (param i64 i64) (result i64) (i64.rem_s (local.get 0) (local.get 1)))
+  (func (export "i64.shl") (param i64 i64) (result i64) (i64.shl (local.get 0) (local.get 1)))
+  (func (export "i64.popcnt") (param i64) (result i64) (i64.popcnt (local.get 0)))
+  (func (export "i64.ge_s") (param i64 i64) (result i32) (i64.ge_s (local.get 0) (local.get 1)))
+
+  (func (export "f32.min") (param f32 f32) (result f32) (f32.min (local.get 0) (local.get 1)))
+  (func (export "f32.max") (param f32 f32) (result f32) (f32.max (local.get 0) (local.get 1)))
+  (func (export "f32.nearest") (param f32) (result f32) (f32.nearest (local.get 0)))
+  (func (export "f32.sqrt") (param f32) (result f32) (f32.sqrt (local.get 0)))
+  (func (export "f32.neg") (param f32) (result f32) (f32.neg (local.get 0)))
+  (func (export "f32.add") (param f32 f32) (result f32) (f32.add (local.get 0) (local.get 1)))
+  (func (export "f32.ne") (param f32 f32) (result i32) (f32.ne (local.get 0) (local.get 1)))
+  (func (export "f64.copysign") (param f64 f64) (result f64) (f64.copysign (local.get 0) (local.get 1)))
+  (func (export "f64.floor") (param f64) (result f64) (f64.floor (local.get 0)))
+  (func (export "f64.max") (param f64 f64) (result f64) (f64.max (local.get 0) (local.get 1)))
+  (func (export "f64.lt") (param f64 f64) (result i32) (f64.lt (local.get 0) (local.get 1)))
+
+  (func (export "i32.trunc_f32_s") (param f32) (result i32) (i32.trunc_f32_s (local.get 0)))
+  (func (export "i32.trunc_f64_u") (param f64) (result i32) (i32.trunc_f64_u (local.get 0)))
+  (func (export "i64.trunc_f64_s") (param f64) (result i64) (i64.trunc_f64_s (local.get 0)))
+  (func (export "i64.trunc_f32_u") (param f32) (result i64) (i64.trunc_f32_u (local.get 0)))
+  (func (export "i32.trunc_sat_f32_s") (param f32) (result i32) (i32.trunc_sat_f32_s (local.get 0)))
+  (func (export "i64.trunc_sat_f64_u") (param f64) (result i64) (i64.trunc_sat_f64_u (local.get 0)))
+  (func (export "i64.trunc_sat_f64_s") (param f64) (result i64) (i64.trunc_sat_f64_s (local.get 0)))
+  (func (export "f32.convert_i64_u") (param i64) (result f32) (f32.convert_i64_u (local.get 0)))
+  (func (export "f64.convert_i32_u") (param i32) (result f64) (f64.convert_i32_u (local.get 0)))
+  (func (export "f32.demote_f64") (param f64) (result f32) (f32.demote_f64 (local.get 0)))
+  (func (export "i64.extend_i32_s") (param i32) (result i64) (i64.extend_i32_s (local.get 0)))
+  (func (export "i32.reinterpret_f32") (param f32) (result i32) (i32.reinterpret_f32 (local.get 0)))
+)
+
+(assert_return (invoke "i32.div_s" (i32.const -7) (i32.const 2)) (i32.const -3))
+(assert_return (invoke "i32.div_u" (i32.const -1) (i32.const 2)) (i32.const 0x7fffffff))
+(assert_trap (invoke "i32.div_s" (i32.const 0x80000000) (i32.const -1)) "integer overflow")
+(assert_trap (invoke "i32.div_u" (i32.const 1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "i32.rem_s" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "i32.rem_s" (i32.const -7) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "i32.shr_s" (i32.const -8) (i32.const 33)) (i32.const -4))
+(assert_return (invoke "i32.rotr" (i32.const 1) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "i32.clz" (i32.const 0)) (i32.const 32))
+(assert_return (invoke "i32.lt_u" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "i32.extend8_s" (i32.const 0x80)) (i32.const -128))
+(assert_trap (invoke "i64.div_s" (i64.const 0x8000000000000000) (i64.const -1)) "integer overflow")
+(assert_return (invoke "i64.rem_s" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "i64.shl" (i64.const 1) (i64.const 65)) (i64.const 2))
+(assert_return (invoke "i64.popcnt" (i64.const -1)) (i64.const 64))
+(assert_return (invoke "i64.ge_s" (i64.const -1) (i64.const 0)) (i32.const 0))
+
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const -0)) (f32.const -0))
+(assert_return (invoke "f32.max" (f32.const -0) (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.min" (f32.const nan) (f32.const 1)) (f32.const nan:canonical))
+(assert_return (invoke "f32.max" (f32.const 1) (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.nearest" (f32.const 2.5)) (f32.const 2))
+(assert_return (invoke "f32.nearest" (f32.const -0.5)) (f32.const -0))
+(assert_return (invoke "f32.nearest" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.sqrt" (f32.const -1)) (f32.const nan:canonical))
+(assert_return (invoke "f32.neg" (f32.const nan:0x200000)) (f32.const -nan:0x200000))
+(assert_return (invoke "f32.add" (f32.const 0x1p+127) (f32.const 0x1p+127)) (f32.const inf))
+(assert_return (invoke "f32.ne" (f32.const nan) (f32.const nan)) (i32.const 1))
+(assert_return (invoke "f64.copysign" (f64.const 1) (f64.const -nan)) (f64.const -1))
+(assert_return (invoke "f64.floor" (f64.const -0.5)) (f64.const -1))
+(assert_return (invoke "f64.floor" (f64.const nan:0x4)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.max" (f64.const -inf) (f64.const -1)) (f64.const -1))
+(assert_return (invoke "f64.lt" (f64.const nan) (f64.const 1)) (i32.const 0))
+
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1p+31)) (i32.const 0x80000000))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -1.9)) (i32.const -1))
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const nan)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_f64_u" (f64.const -0.9)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 4294967295.9)) (i32.const -1))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -1)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1p+63)) (i64.const 0x8000000000000000))
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.fffffep+63)) (i64.const 0xffffff0000000000))
+(assert_return (invoke "i32.trunc_sat_f32_s" (f32.const inf)) (i32.const 0x7fffffff))
+(assert_return (invoke "i32.trunc_sat_f32_s" (f32.const -inf)) (i32.const 0x80000000))
+(assert_return (invoke "i32.trunc_sat_f32_s" (f32.const nan)) (i32.const 0))
+(assert_return (invoke "i64.trunc_sat_f64_u" (f64.const 0x1p+64)) (i64.const -1))
+(assert_return (invoke "i64.trunc_sat_f64_u" (f64.const -1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_sat_f64_s" (f64.const 0x1p+63)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -1)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 0x8000008000000001)) (f32.const 0x1.000002p+63))
+(assert_return (invoke "f64.convert_i32_u" (i32.const -1)) (f64.const 4294967295))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+128)) (f32.const inf))
+(assert_return (invoke "f32.demote_f64" (f64.const nan:0x4000000000000)) (f32.const nan:arithmetic))
+(assert_return (invoke "i64.extend_i32_s" (i32.const -1)) (i64.const -1))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -nan:0x7fffff)) (i32.const -1))
@@ -0,0 +1,73 @@
+;; Execution of indirect calls and table instructions
+
+(module
+  (type $unary (func (param i32) (result i32)))
+  (type $nullary (func (result i32)))
+
+  (table $t 4 8 funcref)
+  (table $ext 2 externref)
+  (elem (table $t) (i32.const 0) func $inc $dec)
+  (elem $passive funcref (ref.func $seven) (ref.null func))
+  (elem declare func $inc)
+
+  (func $inc (param i32) (result i32) (i32.add (local.get 0) (i32.const 1)))
+  (func $dec (param i32) (result i32) (i32.sub (local.get 0) (i32.const 1)))
+  (func $seven (result i32) (i32.const 7))
+
+  (func (export "dispatch") (param i32 i32) (result i32)
+    (call_indirect $t (type $unary) (local.get 1) (local.get 0)))
+  (func (export "dispatch-nullary") (param i32) (result i32)
+    (call_indirect $t (type $nullary) (local.get 0)))
+
+  (func (export "size") (result i32) (table.size $t))
+  (func (export "grow") (param i32) (result i32) (table.grow $t (ref.func $inc) (local.get 0)))
+  (func (export "is-null") (param i32) (result i32) (ref.is_null (table.get $t (local.get 0))))
+  (func (export "clear") (param i32) (table.set $t (local.get 0) (ref.null func)))
+  (func (export "fill") (param i32 i32) (table.fill $t (local.get 0) (ref.func $dec) (local.get 1)))
+  (func (export "copy") (param i32 i32 i32) (table.copy $t $t (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "init") (param i32 i32 i32) (table.init $t $passive (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "drop") (elem.drop $passive))
+
+  (func (export "set-extern") (param i32 externref) (table.set $ext (local.get 0) (local.get 1)))
+  (func (export "get-extern") (param i32) (result externref) (table.get $ext (local.get 0)))
+  (func (export "get-func") (param i32) (result funcref) (table.get $t (local.get 0)))
+)
+
+(assert_return (invoke "dispatch" (i32.const 0) (i32.const 41)) (i32.const 42))
+(assert_return (invoke "dispatch" (i32.const 1) (i32.const 41)) (i32.const 40))
+(assert_trap (invoke "dispatch" (i32.const 2) (i32.const 0)) "uninitialized element")
+(assert_trap (invoke "dispatch" (i32.const 4) (i32.const 0)) "undefined element")
+(assert_trap (invoke "dispatch" (i32.const -1) (i32.const 0)) "undefined element")
+(assert_trap (invoke "dispatch-nullary" (i32.const 0)) "indirect call type mismatch")
+
+(assert_return (invoke "get-func" (i32.const 0)) (ref.func))
+(assert_return (invoke "get-func" (i32.const 2)) (ref.null func))
+(assert_return (invoke "is-null" (i32.const 3)) (i32.const 1))
+(assert_trap (invoke "is-null" (i32.const 4)) "out of bounds table access")
+
+(assert_return (invoke "init" (i32.const 2) (i32.const 0) (i32.const 2)))
+(assert_return (invoke "dispatch-nullary" (i32.const 2)) (i32.const 7))
+(assert_trap (invoke "init" (i32.const 2) (i32.const 1) (i32.const 2)) "out of bounds table access")
+(assert_return (invoke "drop"))
+(assert_trap (invoke "init" (i32.const 0) (i32.const 0) (i32.const 1)) "out of bounds table access")
+
+(assert_return (invoke "copy" (i32.const 3) (i32.const 0) (i32.const 1)))
+(assert_return (invoke "dispatch" (i32.const 3) (i32.const 1)) (i32.const 2))
+(assert_trap (invoke "copy" (i32.const 3) (i32.const 0) (i32.const 2)) "out of bounds table access")
+(assert_return (invoke "clear" (i32.const 0)))
+(assert_trap (invoke "dispatch" (i32.const 0) (i32.const 0)) "uninitialized element")
+
+(assert_return (invoke "size") (i32.const 4))
+(assert_return (invoke "grow" (i32.const 2)) (i32.const 4))
+(assert_return (invoke "dispatch" (i32.const 5) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "grow" (i32.const 3)) (i32.const -1))
+(assert_return (invoke "size") (i32.const 6))
+(assert_return (invoke "fill" (i32.const 4) (i32.const 2)))
+(assert_return (invoke "dispatch" (i32.const 5) (i32.const 1)) (i32.const 0))
+(assert_trap (invoke "fill" (i32.const 5) (i32.const 2)) "out of bounds table access")
+
+(assert_return (invoke "get-extern" (i32.const 1)) (ref.null extern))
+(assert_return (invoke "set-extern" (i32.const 1) (ref.extern 3)))
+(assert_return (invoke "get-extern" (i32.const 1)) (ref.extern 3))
+
+(assert_trap (module (table 1 funcref) (func $f) (elem (i32.const 1) $f $f)) "out of bounds table access")
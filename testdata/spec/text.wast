@@ -0,0 +1,29 @@
+;; Assembling of modules given in the text format
+
+(module $m
+  (func $add (export "add") (param $a i32) (param $b i32) (result i32)
+    (i32.add (local.get $a) (local.get $b)))
+  (func (export "div") (param f32 f32) (result f32)
+    (f32.div (local.get 0) (local.get 1)))
+  (func (export "div_s") (param i32 i32) (result i32)
+    (i32.div_s (local.get 0) (local.get 1)))
+  (global (export "g") i64 (i64.const -1))
+)
+
+(assert_return (invoke "add" (i32.const 1) (i32.const 2)) (i32.const 3))
+(assert_return (invoke $m "div" (f32.const 0) (f32.const 0)) (f32.const nan:canonical))
+(assert_return (get "g") (i64.const -1))
+(register "m" $m)
+
+(module quote "(func (export \"f\") (result i64)" "i64.const 0x7fff_ffff_ffff_ffff)")
+(assert_return (invoke "f") (i64.const 9223372036854775807))
+
+(assert_malformed (module quote "(func i32.bogus)") "unknown operator")
+(assert_malformed (module quote "(func call $missing)") "unknown identifier")
+(assert_malformed (module quote "(func $f) (func $f)") "duplicate func")
+(assert_malformed (module quote "(func i32.const 0x1_0000_0000)") "constant out of range")
+(assert_malformed (module quote "(func block $a end $b)") "mismatching label")
+(assert_malformed (module quote "(memory 1) (func (import \"a\" \"b\"))") "import after memory")
+
+(assert_invalid (module (func (result i32) (i64.const 0))) "type mismatch")
+(assert_trap (invoke $m "div_s" (i32.const 1) (i32.const 0)) "integer divide by zero")
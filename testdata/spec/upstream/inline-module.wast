@@ -0,0 +1 @@
+(func) (memory 0) (func (export "f"))
@@ -0,0 +1,19 @@
+#!/bin/sh
+# Vendors the core scripts of the WebAssembly specification at the given revision into this
+# directory and records the revision in REVISION.
+#
+# Usage: testdata/spec/upstream/update.sh <revision of github.com/WebAssembly/spec>
+set -eu
+
+revision="${1:?usage: $0 <revision>}"
+dir="$(cd "$(dirname "$0")" && pwd)"
+tmp="$(mktemp -d)"
+trap 'rm -rf "$tmp"' EXIT
+
+git -C "$tmp" init -q
+git -C "$tmp" fetch -q --depth 1 https://github.com/WebAssembly/spec "$revision"
+git -C "$tmp" checkout -q FETCH_HEAD
+
+rm -f "$dir"/*.wast
+cp "$tmp"/test/core/*.wast "$dir"
+git -C "$tmp" rev-parse HEAD > "$dir/REVISION"
@@ -0,0 +1,75 @@
+;; Type checking of function bodies and constant expressions
+
+(module
+  (type $pair (func (param i32 i32) (result i32 i32)))
+  (memory 1)
+  (table 2 funcref)
+  (global $g (mut i32) (i32.const 0))
+  (global $c i64 (i64.const 1))
+  (elem declare func $f)
+  (func $f (param i32) (result i32)
+    (block (result i32) (br 0 (local.get 0))))
+  (func (result i32)
+    (loop $l (result i32)
+      (br_if $l (i32.const 0))
+      (i32.const 1)))
+  (func (param i32) (result i32)
+    (if (result i32) (local.get 0)
+      (then (i32.const 1))
+      (else (i32.const 2))))
+  (func (param i32 i32) (result i32 i32)
+    (local.get 0) (local.get 1)
+    (block (type $pair) (param i32 i32) (result i32 i32)))
+  (func (result i32)
+    (unreachable) (i32.add) (drop) (i64.const 0) (i32.const 0) (select) (drop) (i32.const 0))
+  (func (param i32) (result i32)
+    (block $a (result i32)
+      (block $b (result i32)
+        (br_table $a $b (i32.const 7) (local.get 0)))))
+  (func (result funcref) (ref.func $f))
+  (func (global.set $g (i32.add (global.get $g) (i32.const 1))))
+  (func (param i32) (result i64)
+    (i64.extend_i32_u (i32.load offset=4 align=4 (local.get 0))))
+  (func (param i32) (call_indirect (param i32) (local.get 0) (i32.const 0)))
+  (func (result i32) (select (result i32) (i32.const 1) (i32.const 2) (i32.const 0)))
+)
+
+(assert_invalid (module (func (result i32) (i64.const 0))) "type mismatch")
+(assert_invalid (module (func (result i32))) "type mismatch")
+(assert_invalid (module (func (i32.const 0))) "type mismatch")
+(assert_invalid (module (func (i32.add (i32.const 0)))) "type mismatch")
+(assert_invalid (module (func (i32.eqz (f32.const 0)) (drop))) "type mismatch")
+(assert_invalid (module (func (if (i32.const 0) (then (i32.const 1))))) "type mismatch")
+(assert_invalid (module (func (result i32) (if (result i32) (i32.const 0) (then (i32.const 1))))) "type mismatch")
+(assert_invalid (module (func (block (result i32) (br 0)) (drop))) "type mismatch")
+(assert_invalid (module (func (br 1))) "unknown label")
+(assert_invalid (module (func (local.get 0) (drop))) "unknown local")
+(assert_invalid (module (func (global.get 0) (drop))) "unknown global")
+(assert_invalid (module (global i32 (i32.const 0)) (func (global.set 0 (i32.const 1)))) "global is immutable")
+(assert_invalid (module (func (call 1))) "unknown function")
+(assert_invalid (module (func (i32.load (i32.const 0)) (drop))) "unknown memory")
+(assert_invalid (module (memory 1) (func (i32.load align=8 (i32.const 0)) (drop))) "alignment must not be larger than natural")
+(assert_invalid (module (func $f (ref.func $f) (drop))) "undeclared function reference")
+(assert_invalid (module (func (select (ref.null func) (ref.null func) (i32.const 0)) (drop))) "type mismatch")
+(assert_invalid (module (func (select (i32.const 0) (i64.const 0) (i32.const 0)) (drop))) "type mismatch")
+(assert_invalid (module (table 1 externref) (func (call_indirect (i32.const 0)))) "type mismatch")
+(assert_invalid (module (func (unreachable) (br_table 0 1 (f32.const 0)))) "type mismatch")
+(assert_invalid (module (global i32 (i64.const 0))) "type mismatch")
+(assert_invalid (module (global $g (mut i32) (i32.const 0)) (global i32 (global.get $g))) "unknown global")
+(assert_invalid (module (memory 1) (data (i64.const 0) "")) "type mismatch")
+(assert_invalid (module (table 1 funcref) (elem (i32.const 0) externref (ref.null extern))) "type mismatch")
+(assert_invalid (module (data (i32.const 0) "")) "unknown memory")
+
+;; Module-level rules
+
+(assert_invalid (module (func $f (param i32)) (start $f)) "start function")
+(assert_invalid (module (func $f (result i32) (i32.const 0)) (start $f)) "start function")
+(assert_invalid (module (func) (export "a" (func 0)) (export "a" (func 0))) "duplicate export name")
+(assert_invalid (module (export "a" (func 0))) "unknown function")
+(assert_invalid (module (memory 65537)) "memory size must be at most 65536 pages (4GiB)")
+(assert_invalid (module (memory 2 1)) "size minimum must not be greater than maximum")
+(assert_invalid (module (table 2 1 funcref)) "size minimum must not be greater than maximum")
+(assert_invalid (module (memory 1) (memory 1)) "multiple memories")
+(assert_invalid (module (import "a" "b" (memory 1)) (memory 1)) "multiple memories")
+(assert_invalid (module binary "\00asm" "\01\00\00\00" "\03\02\01\00" "\0a\04\01\02\00\0b") "unknown type")
+(assert_malformed (module binary "\00asm" "\01\00\00\00" "\00\02\01\ff") "malformed UTF-8 encoding")
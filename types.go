@@ -1,7 +1,6 @@
 package jwasm
 
 import (
-	"encoding/binary"
 	"fmt"
 	"io"
 )
@@ -56,37 +55,53 @@ const (
 // https://webassembly.github.io/spec/core/syntax/types.html#value-types
 type ValueType interface {
 	valueType()
+	String() string
 }
 
+// Value types are singletons, so that they can be compared with ==.
+var (
+	ValueTypeI32       = &numberType{NumberTypeI32, "i32"}
+	ValueTypeI64       = &numberType{NumberTypeI64, "i64"}
+	ValueTypeF32       = &numberType{NumberTypeF32, "f32"}
+	ValueTypeF64       = &numberType{NumberTypeF64, "f64"}
+	ValueTypeV128      = &vectorType{VectorTypeV128, "v128"}
+	ValueTypeFuncRef   = &referenceType{ReferenceTypeFuncRef, "funcref"}
+	ValueTypeExternRef = &referenceType{ReferenceTypeExternRef, "externref"}
+)
+
 func parseValueType(r io.Reader) (ValueType, error) {
 	// https://webassembly.github.io/spec/core/binary/types.html#value-types
 	//
 	// Value types are encoded with their respective encoding as a number type, vector type, or reference type.
 
-	var b byte
-	err := binary.Read(r, binary.BigEndian, &b)
+	b, err := readByte(r)
 	if err != nil {
 		return nil, fmt.Errorf("reading value type byte failed: %w", err)
 	}
 
+	return valueTypeOf(b)
+}
+
+// valueTypeOf returns the value type encoded by the byte b.
+func valueTypeOf(b byte) (ValueType, error) {
 	switch b {
 	// Number Type
 	case byte(NumberTypeI32):
-		return &numberType{NumberTypeI32, "i32"}, nil
+		return ValueTypeI32, nil
 	case byte(NumberTypeI64):
-		return &numberType{NumberTypeI64, "i64"}, nil
+		return ValueTypeI64, nil
 	case byte(NumberTypeF32):
-		return &numberType{NumberTypeF32, "f32"}, nil
+		return ValueTypeF32, nil
 	case byte(NumberTypeF64):
-		return &numberType{NumberTypeF64, "f64"}, nil
+		return ValueTypeF64, nil
 	// Vector Type
 	case byte(VectorTypeV128):
-		return &vectorType{VectorTypeV128, "v128"}, nil
+		return ValueTypeV128, nil
 	// Reference Type
 	case byte(ReferenceTypeFuncRef):
-		return &referenceType{ReferenceTypeFuncRef, "funcref"}, nil
+		return ValueTypeFuncRef, nil
 	case byte(ReferenceTypeExternRef):
-		return &referenceType{ReferenceTypeExternRef, "externref"}, nil
+		return ValueTypeExternRef, nil
 	default:
 		return nil, fmt.Errorf("reading value type failed, unknown code [0x%x]", b)
 	}
@@ -134,8 +149,7 @@ func parseFunctionType(r io.Reader) (FunctionType, error) {
 	// vectors of parameter and result types.
 
 	// Read function type header
-	var header byte
-	err := binary.Read(r, binary.BigEndian, &header)
+	header, err := readByte(r)
 	if err != nil {
 		return FunctionType{}, fmt.Errorf("reading function type header failed: %w", err)
 	}
@@ -156,3 +170,137 @@ func parseFunctionType(r io.Reader) (FunctionType, error) {
 
 	return FunctionType{rt1, rt2}, nil
 }
+
+func parseReferenceType(r io.Reader) (*referenceType, error) {
+	// https://webassembly.github.io/spec/core/binary/types.html#reference-types
+	//
+	// Reference types are encoded by a single byte.
+
+	valueType, err := parseValueType(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading reference type failed: %w", err)
+	}
+
+	referenceType, ok := valueType.(*referenceType)
+	if !ok {
+		return nil, fmt.Errorf("malformed reference type, got non-reference type [%s]", valueType)
+	}
+
+	return referenceType, nil
+}
+
+// https://webassembly.github.io/spec/core/syntax/types.html#limits
+type Limits struct {
+	Min uint32
+	Max *uint32
+}
+
+func parseLimits(r io.Reader) (Limits, error) {
+	// https://webassembly.github.io/spec/core/binary/types.html#limits
+	//
+	// Limits are encoded with a preceding flag indicating whether a maximum is present.
+
+	flag, err := readByte(r)
+	if err != nil {
+		return Limits{}, fmt.Errorf("reading limits flag failed: %w", err)
+	}
+
+	if flag != 0x00 && flag != 0x01 {
+		return Limits{}, fmt.Errorf("limits flag wrong, expected [0x00] or [0x01], got [0x%x]", flag)
+	}
+
+	min, err := ReadUint32(r)
+	if err != nil {
+		return Limits{}, fmt.Errorf("reading limits min failed: %w", err)
+	}
+
+	if flag == 0x00 {
+		return Limits{min, nil}, nil
+	}
+
+	max, err := ReadUint32(r)
+	if err != nil {
+		return Limits{}, fmt.Errorf("reading limits max failed: %w", err)
+	}
+
+	return Limits{min, &max}, nil
+}
+
+// https://webassembly.github.io/spec/core/syntax/types.html#memory-types
+type MemoryType struct {
+	Limits Limits
+}
+
+// maxMemoryPages is the largest size of a memory in pages of 64 KiB, which spans the
+// whole 32-bit address space.
+// https://webassembly.github.io/spec/core/valid/types.html#memory-types
+const maxMemoryPages = 65536
+
+func parseMemoryType(r io.Reader) (MemoryType, error) {
+	// https://webassembly.github.io/spec/core/binary/types.html#memory-types
+	//
+	// Memory types are encoded with their limits.
+
+	limits, err := parseLimits(r)
+	if err != nil {
+		return MemoryType{}, fmt.Errorf("parsing memory type failed: %w", err)
+	}
+
+	return MemoryType{limits}, nil
+}
+
+// https://webassembly.github.io/spec/core/syntax/types.html#table-types
+type TableType struct {
+	ElementType ValueType
+	Limits      Limits
+}
+
+func parseTableType(r io.Reader) (TableType, error) {
+	// https://webassembly.github.io/spec/core/binary/types.html#table-types
+	//
+	// Table types are encoded with their limits and the encoding of their element
+	// reference type.
+
+	elementType, err := parseReferenceType(r)
+	if err != nil {
+		return TableType{}, fmt.Errorf("parsing table element type failed: %w", err)
+	}
+
+	limits, err := parseLimits(r)
+	if err != nil {
+		return TableType{}, fmt.Errorf("parsing table limits failed: %w", err)
+	}
+
+	return TableType{elementType, limits}, nil
+}
+
+// https://webassembly.github.io/spec/core/syntax/types.html#global-types
+type GlobalType struct {
+	ValueType ValueType
+	Mutable   bool
+}
+
+func parseGlobalType(r io.Reader) (GlobalType, error) {
+	// https://webassembly.github.io/spec/core/binary/types.html#global-types
+	//
+	// Global types are encoded by their value type and a flag for their mutability.
+
+	valueType, err := parseValueType(r)
+	if err != nil {
+		return GlobalType{}, fmt.Errorf("parsing global value type failed: %w", err)
+	}
+
+	mutability, err := readByte(r)
+	if err != nil {
+		return GlobalType{}, fmt.Errorf("reading global mutability failed: %w", err)
+	}
+
+	switch mutability {
+	case 0x00:
+		return GlobalType{valueType, false}, nil
+	case 0x01:
+		return GlobalType{valueType, true}, nil
+	default:
+		return GlobalType{}, fmt.Errorf("malformed mutability, expected [0x00] or [0x01], got [0x%x]", mutability)
+	}
+}
@@ -0,0 +1,1114 @@
+package jwasm
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validate checks that m is valid. It returns the first *Diagnostic of ValidateModule if
+// there is one, otherwise it checks that all function bodies and constant expressions are
+// well typed and returns a *ValidationError for the first invalid instruction.
+// https://webassembly.github.io/spec/core/valid/index.html
+func Validate(m *Module) error {
+	if diagnostics := ValidateModule(m); len(diagnostics) > 0 {
+		return diagnostics[0]
+	}
+
+	c := newValidationContext(m)
+
+	if m.GlobalSection != nil {
+		imported := uint32(len(m.importedGlobals()))
+		for i, global := range m.GlobalSection.globals {
+			// Constant expressions may only refer to imported globals
+			err := c.constantExpression(global.init, global.globalType.ValueType, imported)
+			if err != nil {
+				return sectionValidationError(globalSectionId, fmt.Sprintf("global [%d]", int(imported)+i), err)
+			}
+		}
+	}
+
+	if m.ElementSection != nil {
+		for i, element := range m.ElementSection.elements {
+			err := c.element(element)
+			if err != nil {
+				return sectionValidationError(elementSectionId, fmt.Sprintf("element segment [%d]", i), err)
+			}
+		}
+	}
+
+	if m.DataSection != nil {
+		for i, data := range m.DataSection.data {
+			err := c.data(data)
+			if err != nil {
+				return sectionValidationError(dataSectionId, fmt.Sprintf("data segment [%d]", i), err)
+			}
+		}
+	}
+
+	if m.CodeSection != nil {
+		imported := len(m.importedFunctions())
+		for i, code := range m.CodeSection.functionCode {
+			idx := uint32(imported + i)
+			err := c.function(functionIndex(idx), code)
+			if err != nil {
+				err := sectionValidationError(codeSectionId, "", err)
+				err.FunctionIndex = &idx
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validationContext holds the definitions of a module that instructions may refer to.
+// https://webassembly.github.io/spec/core/valid/conventions.html#contexts
+type validationContext struct {
+	m *Module
+	// importedGlobals is the number of imported globals, which precede the other globals
+	importedGlobals uint32
+	// refs holds the functions that may be referenced by ref.func inside function bodies
+	refs map[functionIndex]bool
+}
+
+func newValidationContext(m *Module) *validationContext {
+	c := &validationContext{m: m, importedGlobals: uint32(len(m.importedGlobals())), refs: make(map[functionIndex]bool)}
+
+	// https://webassembly.github.io/spec/core/valid/modules.html#valid-module
+	//
+	// Functions are declared for reference by their occurrence in exports, global
+	// initializers and element segments.
+	addRefs := func(instructions []instruction) {
+		for _, instruction := range instructions {
+			if refFunc, ok := instruction.(*refFunc); ok {
+				c.refs[refFunc.x] = true
+			}
+		}
+	}
+
+	if m.ExportSection != nil {
+		for _, export := range m.ExportSection.exports {
+			if desc, ok := export.exportDescription.(*exportDescriptionFunc); ok {
+				c.refs[desc.functionIndex] = true
+			}
+		}
+	}
+
+	if m.GlobalSection != nil {
+		for _, global := range m.GlobalSection.globals {
+			addRefs(global.init)
+		}
+	}
+
+	if m.ElementSection != nil {
+		for _, element := range m.ElementSection.elements {
+			for _, x := range element.functionIndices {
+				c.refs[x] = true
+			}
+			for _, init := range element.init {
+				addRefs(init)
+			}
+		}
+	}
+
+	return c
+}
+
+func (c *validationContext) element(element element) error {
+	for _, x := range element.functionIndices {
+		if _, err := c.m.functionType(x); err != nil {
+			return &ValidationError{Err: fmt.Errorf("%w %d", ErrUnknownFunction, x)}
+		}
+	}
+
+	for _, init := range element.init {
+		err := c.constantExpression(init, element.elementType, c.importedGlobals)
+		if err != nil {
+			return err
+		}
+	}
+
+	if mode, ok := element.mode.(*elementModeActive); ok {
+		tableType, err := c.m.tableType(mode.table)
+		if err != nil {
+			return &ValidationError{Err: fmt.Errorf("%w %d", ErrUnknownTable, mode.table)}
+		}
+
+		if tableType.ElementType != element.elementType {
+			return &ValidationError{Err: fmt.Errorf("%w, cannot initialize table of [%s] with [%s]", ErrTypeMismatch, tableType.ElementType, element.elementType)}
+		}
+
+		return c.constantExpression(mode.offset, ValueTypeI32, c.importedGlobals)
+	}
+
+	return nil
+}
+
+func (c *validationContext) data(data data) error {
+	if mode, ok := data.mode.(*dataModeActive); ok {
+		if _, err := c.m.memoryType(mode.memory); err != nil {
+			return &ValidationError{Err: fmt.Errorf("%w %d", ErrUnknownMemory, mode.memory)}
+		}
+
+		return c.constantExpression(mode.offset, ValueTypeI32, c.importedGlobals)
+	}
+
+	return nil
+}
+
+// constantExpression checks that expression is constant and produces a value of type t.
+// Only the first globals may be read.
+// https://webassembly.github.io/spec/core/valid/instructions.html#constant-expressions
+func (c *validationContext) constantExpression(expression []instruction, t ValueType, globals uint32) error {
+	v := newFunctionValidator(c, nil, FunctionType{nil, ResultType{t}})
+
+	for _, instruction := range expression {
+		v.current = v.offset
+
+		switch i := instruction.(type) {
+		case *int32Const, *int64Const, *float32Const, *float64Const, *refNull, *refFunc:
+		case *globalGet:
+			if uint32(i.x) >= globals {
+				return v.errorf(ErrUnknownGlobal, "%d, constant expressions may only read imported globals", i.x)
+			}
+
+			globalType, _ := c.m.globalType(i.x)
+			if globalType.Mutable {
+				return v.errorf(ErrConstantExpressionRequired, "global [%d] is mutable", i.x)
+			}
+		default:
+			return v.errorf(ErrConstantExpressionRequired, "%s is not constant", instructionName(instruction))
+		}
+
+		if err := v.instruction(instruction); err != nil {
+			return err
+		}
+	}
+
+	v.current = v.offset
+	return v.end()
+}
+
+// maxLocals limits the number of parameters and locals of a function, which the binary
+// format allows up to 2^32-1 of.
+const maxLocals = 50000
+
+func (c *validationContext) function(idx functionIndex, code functionCode) error {
+	functionType, err := c.m.functionType(idx)
+	if err != nil {
+		return &ValidationError{Err: fmt.Errorf("%w, %w", ErrUnknownType, err)}
+	}
+
+	// Calls allocate all locals on the stack up front
+	locals := uint64(len(functionType.ParameterTypes))
+	for _, run := range code.locals {
+		locals += uint64(run.n)
+	}
+	if locals > maxLocals {
+		return &ValidationError{Err: fmt.Errorf("%w, got [%d], expected at most [%d]", ErrTooManyLocals, locals, maxLocals)}
+	}
+
+	v := newFunctionValidator(c, &code, functionType)
+	err = v.instructions(code.body)
+	if err != nil {
+		return err
+	}
+
+	v.current = v.offset
+	return v.end()
+}
+
+// Operand and control stacks
+// https://webassembly.github.io/spec/core/appendix/algorithm.html
+
+// controlFrame is an entered block, whose results are checked when the block ends.
+type controlFrame struct {
+	op          opcode
+	start       ResultType
+	end         ResultType
+	height      int
+	unreachable bool
+}
+
+// functionValidator type checks the instructions of one function or constant expression.
+// Values of unknown type are represented by nil on the operand stack.
+type functionValidator struct {
+	c            *validationContext
+	code         *functionCode
+	functionType FunctionType
+
+	values   []ValueType
+	controls []controlFrame
+
+	// offset is the position in bytes of the next instruction, current the one of the
+	// instruction being validated
+	offset  int64
+	current int64
+	buf     []byte
+}
+
+func newFunctionValidator(c *validationContext, code *functionCode, functionType FunctionType) *functionValidator {
+	v := &functionValidator{c: c, code: code, functionType: functionType}
+	v.pushControl(0x02, nil, functionType.ResultTypes)
+	return v
+}
+
+func (v *functionValidator) errorf(err error, format string, args ...any) *ValidationError {
+	return &ValidationError{Offset: v.current, Err: fmt.Errorf("%w, %s", err, fmt.Sprintf(format, args...))}
+}
+
+func (v *functionValidator) push(t ValueType) {
+	v.values = append(v.values, t)
+}
+
+func (v *functionValidator) pushValues(types ResultType) {
+	v.values = append(v.values, types...)
+}
+
+// pop removes the top value, which is of unknown type if the current block is unreachable.
+func (v *functionValidator) pop() (ValueType, error) {
+	frame := &v.controls[len(v.controls)-1]
+	if len(v.values) == frame.height {
+		if frame.unreachable {
+			return nil, nil
+		}
+		return nil, v.errorf(ErrTypeMismatch, "expected a value, got an empty stack")
+	}
+
+	t := v.values[len(v.values)-1]
+	v.values = v.values[:len(v.values)-1]
+	return t, nil
+}
+
+// popExpect removes the top value and checks that it is of type expected, nil matches any.
+func (v *functionValidator) popExpect(expected ValueType) (ValueType, error) {
+	actual, err := v.pop()
+	if err != nil {
+		if expected != nil {
+			return nil, v.errorf(ErrTypeMismatch, "expected [%s], got an empty stack", expected)
+		}
+		return nil, err
+	}
+
+	if actual != nil && expected != nil && actual != expected {
+		return nil, v.errorf(ErrTypeMismatch, "expected [%s], got [%s]", expected, actual)
+	}
+
+	if actual == nil {
+		return expected, nil
+	}
+	return actual, nil
+}
+
+func (v *functionValidator) popValues(types ResultType) error {
+	for i := len(types) - 1; i >= 0; i-- {
+		if _, err := v.popExpect(types[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *functionValidator) pushControl(op opcode, start, end ResultType) {
+	v.controls = append(v.controls, controlFrame{op, start, end, len(v.values), false})
+	v.pushValues(start)
+}
+
+// popControl ends the current block, whose results must be exactly on the stack.
+func (v *functionValidator) popControl() (controlFrame, error) {
+	frame := v.controls[len(v.controls)-1]
+	if err := v.popValues(frame.end); err != nil {
+		return controlFrame{}, err
+	}
+
+	if len(v.values) != frame.height {
+		return controlFrame{}, v.errorf(ErrTypeMismatch, "expected [%s], got [%d] values more", valueTypesString(frame.end), len(v.values)-frame.height)
+	}
+
+	v.controls = v.controls[:len(v.controls)-1]
+	return frame, nil
+}
+
+// labelTypes returns the values that a branch to the block passes, loops are continued
+// with their parameters.
+func labelTypes(frame controlFrame) ResultType {
+	if frame.op == 0x03 {
+		return frame.start
+	}
+	return frame.end
+}
+
+func (v *functionValidator) setUnreachable() {
+	frame := &v.controls[len(v.controls)-1]
+	v.values = v.values[:frame.height]
+	frame.unreachable = true
+}
+
+// end validates the end of the function or constant expression.
+func (v *functionValidator) end() error {
+	_, err := v.popControl()
+	return err
+}
+
+func valueTypesString(types ResultType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return strings.Join(names, " ")
+}
+
+// Instructions
+
+func (v *functionValidator) instructions(instructions []instruction) error {
+	for _, instruction := range instructions {
+		v.current = v.offset
+		if err := v.instruction(instruction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *functionValidator) label(l labelIndex) (controlFrame, error) {
+	if int(l) >= len(v.controls) {
+		return controlFrame{}, v.errorf(ErrUnknownLabel, "%d", l)
+	}
+	return v.controls[len(v.controls)-1-int(l)], nil
+}
+
+func (v *functionValidator) blockType(bt blockType) (FunctionType, error) {
+	// https://webassembly.github.io/spec/core/valid/types.html#block-types
+	switch bt := bt.(type) {
+	case *blockTypeValue:
+		return FunctionType{nil, ResultType{bt.t}}, nil
+	case *blockTypeIndex:
+		if v.c.m.TypeSection == nil || int(bt.x) >= len(v.c.m.TypeSection.FunctionTypes) {
+			return FunctionType{}, v.errorf(ErrUnknownType, "%d", bt.x)
+		}
+		return v.c.m.TypeSection.FunctionTypes[bt.x], nil
+	default:
+		return FunctionType{}, nil
+	}
+}
+
+// structured validates a block, loop or if, whose header precedes the nested instructions.
+func (v *functionValidator) structured(op opcode, bt blockType, instructions []instruction, elseInstructions []instruction) error {
+	// The function itself is the outermost control frame
+	if len(v.controls) > maxNestingDepth {
+		return v.errorf(ErrNestingTooDeep, "more than [%d] levels", maxNestingDepth)
+	}
+
+	v.buf = appendBlockType(appendOpcode(v.buf[:0], op), bt)
+	v.offset += int64(len(v.buf))
+
+	functionType, err := v.blockType(bt)
+	if err != nil {
+		return err
+	}
+
+	if op == 0x04 {
+		if _, err := v.popExpect(ValueTypeI32); err != nil {
+			return err
+		}
+	}
+
+	if err := v.popValues(functionType.ParameterTypes); err != nil {
+		return err
+	}
+
+	v.pushControl(op, functionType.ParameterTypes, functionType.ResultTypes)
+	if err := v.instructions(instructions); err != nil {
+		return err
+	}
+
+	// An if without else behaves like one with an empty else branch, so its parameters
+	// have to match its results
+	if op == 0x04 {
+		v.current = v.offset
+		if elseInstructions != nil {
+			v.offset++
+		}
+
+		frame, err := v.popControl()
+		if err != nil {
+			return err
+		}
+
+		v.pushControl(0x05, frame.start, frame.end)
+		if err := v.instructions(elseInstructions); err != nil {
+			return err
+		}
+	}
+
+	v.current = v.offset
+	v.offset++
+	frame, err := v.popControl()
+	if err != nil {
+		return err
+	}
+
+	v.pushValues(frame.end)
+	return nil
+}
+
+func (v *functionValidator) local(x localIndex) (ValueType, error) {
+	if v.code == nil {
+		return nil, v.errorf(ErrUnknownLocal, "%d", x)
+	}
+
+	t, err := v.code.localType(v.functionType.ParameterTypes, x)
+	if err != nil {
+		return nil, v.errorf(ErrUnknownLocal, "%d", x)
+	}
+	return t, nil
+}
+
+func (v *functionValidator) global(x globalIndex) (GlobalType, error) {
+	globalType, err := v.c.m.globalType(x)
+	if err != nil {
+		return GlobalType{}, v.errorf(ErrUnknownGlobal, "%d", x)
+	}
+	return globalType, nil
+}
+
+func (v *functionValidator) table(x tableIndex) (TableType, error) {
+	tableType, err := v.c.m.tableType(x)
+	if err != nil {
+		return TableType{}, v.errorf(ErrUnknownTable, "%d", x)
+	}
+	return tableType, nil
+}
+
+func (v *functionValidator) memory() error {
+	if _, err := v.c.m.memoryType(0); err != nil {
+		return v.errorf(ErrUnknownMemory, "0")
+	}
+	return nil
+}
+
+func (v *functionValidator) element(x elementIndex) (*referenceType, error) {
+	if v.c.m.ElementSection == nil || int(x) >= len(v.c.m.ElementSection.elements) {
+		return nil, v.errorf(ErrUnknownElementSegment, "%d", x)
+	}
+	return v.c.m.ElementSection.elements[x].elementType, nil
+}
+
+func (v *functionValidator) data(instruction instruction, x dataIndex) error {
+	// https://webassembly.github.io/spec/core/binary/modules.html#data-count-section
+	if v.c.m.DataCountSection == nil {
+		return v.errorf(ErrDataCountRequired, "%s", instructionName(instruction))
+	}
+
+	if uint32(x) >= v.c.m.DataCountSection.count {
+		return v.errorf(ErrUnknownDataSegment, "%d", x)
+	}
+	return nil
+}
+
+func isReferenceType(t ValueType) bool {
+	_, ok := t.(*referenceType)
+	return ok
+}
+
+func (v *functionValidator) instruction(instruction instruction) error {
+	op := instruction.opcode()
+
+	switch i := instruction.(type) {
+	case *block:
+		return v.structured(op, i.bt, i.instructions, nil)
+	case *loop:
+		return v.structured(op, i.bt, i.instructions, nil)
+	case *ifInstruction:
+		return v.structured(op, i.bt, i.instructions, i.elseInstructions)
+	}
+
+	v.buf = appendInstruction(v.buf[:0], instruction)
+	v.offset += int64(len(v.buf))
+
+	switch i := instruction.(type) {
+	// Control Instructions
+	case *unreachable:
+		v.setUnreachable()
+	case *nop:
+	case *br:
+		frame, err := v.label(i.l)
+		if err != nil {
+			return err
+		}
+
+		if err := v.popValues(labelTypes(frame)); err != nil {
+			return err
+		}
+		v.setUnreachable()
+	case *brIf:
+		frame, err := v.label(i.l)
+		if err != nil {
+			return err
+		}
+
+		if _, err := v.popExpect(ValueTypeI32); err != nil {
+			return err
+		}
+
+		if err := v.popValues(labelTypes(frame)); err != nil {
+			return err
+		}
+		v.pushValues(labelTypes(frame))
+	case *brTable:
+		if _, err := v.popExpect(ValueTypeI32); err != nil {
+			return err
+		}
+
+		defaultFrame, err := v.label(i.lN)
+		if err != nil {
+			return err
+		}
+
+		arity := len(labelTypes(defaultFrame))
+		for _, l := range i.l {
+			frame, err := v.label(l)
+			if err != nil {
+				return err
+			}
+
+			types := labelTypes(frame)
+			if len(types) != arity {
+				return v.errorf(ErrTypeMismatch, "label [%d] has [%d] values, the default label [%d]", l, len(types), arity)
+			}
+
+			// Values of unknown type have to match the types of all labels
+			values := slices.Clone(v.values)
+			if err := v.popValues(types); err != nil {
+				return err
+			}
+			v.values = values
+		}
+
+		if err := v.popValues(labelTypes(defaultFrame)); err != nil {
+			return err
+		}
+		v.setUnreachable()
+	case *returnInstruction:
+		if err := v.popValues(v.functionType.ResultTypes); err != nil {
+			return err
+		}
+		v.setUnreachable()
+	case *call:
+		functionType, err := v.c.m.functionType(i.x)
+		if err != nil {
+			return v.errorf(ErrUnknownFunction, "%d", i.x)
+		}
+
+		if err := v.popValues(functionType.ParameterTypes); err != nil {
+			return err
+		}
+		v.pushValues(functionType.ResultTypes)
+	case *callIndirect:
+		tableType, err := v.table(i.x)
+		if err != nil {
+			return err
+		}
+
+		if tableType.ElementType != ValueTypeFuncRef {
+			return v.errorf(ErrTypeMismatch, "table [%d] has element type [%s], expected [funcref]", i.x, tableType.ElementType)
+		}
+
+		functionType, err := v.blockType(&blockTypeIndex{i.y})
+		if err != nil {
+			return err
+		}
+
+		if _, err := v.popExpect(ValueTypeI32); err != nil {
+			return err
+		}
+
+		if err := v.popValues(functionType.ParameterTypes); err != nil {
+			return err
+		}
+		v.pushValues(functionType.ResultTypes)
+	// Reference Instructions
+	case *refNull:
+		v.push(i.t)
+	case *refIsNull:
+		t, err := v.pop()
+		if err != nil {
+			return err
+		}
+
+		if t != nil && !isReferenceType(t) {
+			return v.errorf(ErrTypeMismatch, "expected a reference, got [%s]", t)
+		}
+		v.push(ValueTypeI32)
+	case *refFunc:
+		if _, err := v.c.m.functionType(i.x); err != nil {
+			return v.errorf(ErrUnknownFunction, "%d", i.x)
+		}
+
+		// Constant expressions declare the functions they refer to themselves
+		if v.code != nil && !v.c.refs[i.x] {
+			return v.errorf(ErrUndeclaredFunctionReference, "%d", i.x)
+		}
+		v.push(ValueTypeFuncRef)
+	// Parametric Instructions
+	case *drop:
+		if _, err := v.pop(); err != nil {
+			return err
+		}
+	case *selectInstruction:
+		if _, err := v.popExpect(ValueTypeI32); err != nil {
+			return err
+		}
+
+		t1, err := v.pop()
+		if err != nil {
+			return err
+		}
+
+		t2, err := v.pop()
+		if err != nil {
+			return err
+		}
+
+		// Without a type annotation, only numeric and vector operands can be selected
+		if isReferenceType(t1) || isReferenceType(t2) {
+			return v.errorf(ErrTypeMismatch, "select without type cannot select references")
+		}
+
+		if t1 != nil && t2 != nil && t1 != t2 {
+			return v.errorf(ErrTypeMismatch, "select operands [%s] and [%s] differ", t2, t1)
+		}
+
+		if t1 == nil {
+			t1 = t2
+		}
+		v.push(t1)
+	case *selectTyped:
+		if len(i.t) != 1 {
+			return v.errorf(ErrInvalidResultArity, "select must have exactly one result type, got [%d]", len(i.t))
+		}
+
+		if _, err := v.popExpect(ValueTypeI32); err != nil {
+			return err
+		}
+
+		if err := v.popValues(ResultType{i.t[0], i.t[0]}); err != nil {
+			return err
+		}
+		v.push(i.t[0])
+	// Variable Instructions
+	case *localGet:
+		t, err := v.local(i.x)
+		if err != nil {
+			return err
+		}
+		v.push(t)
+	case *localSet:
+		t, err := v.local(i.x)
+		if err != nil {
+			return err
+		}
+
+		if _, err := v.popExpect(t); err != nil {
+			return err
+		}
+	case *localTee:
+		t, err := v.local(i.x)
+		if err != nil {
+			return err
+		}
+
+		if _, err := v.popExpect(t); err != nil {
+			return err
+		}
+		v.push(t)
+	case *globalGet:
+		globalType, err := v.global(i.x)
+		if err != nil {
+			return err
+		}
+		v.push(globalType.ValueType)
+	case *globalSet:
+		globalType, err := v.global(i.x)
+		if err != nil {
+			return err
+		}
+
+		if !globalType.Mutable {
+			return v.errorf(ErrImmutableGlobal, "%d", i.x)
+		}
+
+		if _, err := v.popExpect(globalType.ValueType); err != nil {
+			return err
+		}
+	// Table Instructions
+	case *tableGet:
+		tableType, err := v.table(i.x)
+		if err != nil {
+			return err
+		}
+
+		if _, err := v.popExpect(ValueTypeI32); err != nil {
+			return err
+		}
+		v.push(tableType.ElementType)
+	case *tableSet:
+		tableType, err := v.table(i.x)
+		if err != nil {
+			return err
+		}
+
+		if err := v.popValues(ResultType{ValueTypeI32, tableType.ElementType}); err != nil {
+			return err
+		}
+	case *tableSize:
+		if _, err := v.table(i.x); err != nil {
+			return err
+		}
+		v.push(ValueTypeI32)
+	case *tableGrow:
+		tableType, err := v.table(i.x)
+		if err != nil {
+			return err
+		}
+
+		if err := v.popValues(ResultType{tableType.ElementType, ValueTypeI32}); err != nil {
+			return err
+		}
+		v.push(ValueTypeI32)
+	case *tableFill:
+		tableType, err := v.table(i.x)
+		if err != nil {
+			return err
+		}
+
+		if err := v.popValues(ResultType{ValueTypeI32, tableType.ElementType, ValueTypeI32}); err != nil {
+			return err
+		}
+	case *tableCopy:
+		destination, err := v.table(i.x)
+		if err != nil {
+			return err
+		}
+
+		source, err := v.table(i.y)
+		if err != nil {
+			return err
+		}
+
+		if destination.ElementType != source.ElementType {
+			return v.errorf(ErrTypeMismatch, "cannot copy [%s] into table of [%s]", source.ElementType, destination.ElementType)
+		}
+
+		if err := v.popValues(ResultType{ValueTypeI32, ValueTypeI32, ValueTypeI32}); err != nil {
+			return err
+		}
+	case *tableInit:
+		tableType, err := v.table(i.x)
+		if err != nil {
+			return err
+		}
+
+		elementType, err := v.element(i.y)
+		if err != nil {
+			return err
+		}
+
+		if tableType.ElementType != elementType {
+			return v.errorf(ErrTypeMismatch, "cannot initialize table of [%s] with [%s]", tableType.ElementType, elementType)
+		}
+
+		if err := v.popValues(ResultType{ValueTypeI32, ValueTypeI32, ValueTypeI32}); err != nil {
+			return err
+		}
+	case *elemDrop:
+		if _, err := v.element(i.x); err != nil {
+			return err
+		}
+	// Memory Instructions
+	case memoryAccess:
+		if err := v.memory(); err != nil {
+			return err
+		}
+
+		if i.memoryArgument().align > naturalAlignment(op) {
+			return v.errorf(ErrAlignmentTooLarge, "2**%d is larger than 2**%d", i.memoryArgument().align, naturalAlignment(op))
+		}
+
+		return v.numeric(instruction)
+	case *memorySize, *memoryGrow, *memoryFill, *memoryCopy:
+		if err := v.memory(); err != nil {
+			return err
+		}
+
+		return v.numeric(instruction)
+	case *memoryInit:
+		if err := v.memory(); err != nil {
+			return err
+		}
+
+		if err := v.data(instruction, i.x); err != nil {
+			return err
+		}
+
+		return v.popValues(ResultType{ValueTypeI32, ValueTypeI32, ValueTypeI32})
+	case *dataDrop:
+		return v.data(instruction, i.x)
+	// Numeric Instructions
+	case *int32Const:
+		v.push(ValueTypeI32)
+	case *int64Const:
+		v.push(ValueTypeI64)
+	case *float32Const:
+		v.push(ValueTypeF32)
+	case *float64Const:
+		v.push(ValueTypeF64)
+	default:
+		return v.numeric(instruction)
+	}
+
+	return nil
+}
+
+// numeric validates instructions whose operand and result types depend only on the opcode.
+func (v *functionValidator) numeric(instruction instruction) error {
+	signature, ok := instructionSignatures[instruction.opcode()]
+	if !ok {
+		return v.errorf(ErrUnknownOpcode, "%s cannot be validated", instructionName(instruction))
+	}
+
+	if err := v.popValues(signature.ParameterTypes); err != nil {
+		return err
+	}
+
+	v.pushValues(signature.ResultTypes)
+	return nil
+}
+
+func instructionName(instruction instruction) string {
+	if name, ok := opcodeNames[instruction.opcode()]; ok {
+		return name
+	}
+	return fmt.Sprintf("instruction [0x%x]", instruction.opcode())
+}
+
+// instructionSignatures holds the types of instructions without type-dependent immediates.
+// https://webassembly.github.io/spec/core/valid/instructions.html#numeric-instructions
+var instructionSignatures = func() map[opcode]FunctionType {
+	i32, i64, f32, f64 := ValueTypeI32, ValueTypeI64, ValueTypeF32, ValueTypeF64
+	signatures := make(map[opcode]FunctionType)
+
+	add := func(first, last opcode, parameters ResultType, results ...ValueType) {
+		for op := first; op <= last; op++ {
+			signatures[op] = FunctionType{parameters, results}
+		}
+	}
+
+	// Memory Instructions
+	add(0x28, 0x28, ResultType{i32}, i32)
+	add(0x29, 0x29, ResultType{i32}, i64)
+	add(0x2A, 0x2A, ResultType{i32}, f32)
+	add(0x2B, 0x2B, ResultType{i32}, f64)
+	add(0x2C, 0x2F, ResultType{i32}, i32)
+	add(0x30, 0x35, ResultType{i32}, i64)
+	add(0x36, 0x36, ResultType{i32, i32})
+	add(0x37, 0x37, ResultType{i32, i64})
+	add(0x38, 0x38, ResultType{i32, f32})
+	add(0x39, 0x39, ResultType{i32, f64})
+	add(0x3A, 0x3B, ResultType{i32, i32})
+	add(0x3C, 0x3E, ResultType{i32, i64})
+	add(0x3F, 0x3F, nil, i32)
+	add(0x40, 0x40, ResultType{i32}, i32)
+	add(0xFC0A, 0xFC0B, ResultType{i32, i32, i32})
+
+	// Comparisons
+	add(0x45, 0x45, ResultType{i32}, i32)
+	add(0x46, 0x4F, ResultType{i32, i32}, i32)
+	add(0x50, 0x50, ResultType{i64}, i32)
+	add(0x51, 0x5A, ResultType{i64, i64}, i32)
+	add(0x5B, 0x60, ResultType{f32, f32}, i32)
+	add(0x61, 0x66, ResultType{f64, f64}, i32)
+
+	// Arithmetic
+	add(0x67, 0x69, ResultType{i32}, i32)
+	add(0x6A, 0x78, ResultType{i32, i32}, i32)
+	add(0x79, 0x7B, ResultType{i64}, i64)
+	add(0x7C, 0x8A, ResultType{i64, i64}, i64)
+	add(0x8B, 0x91, ResultType{f32}, f32)
+	add(0x92, 0x98, ResultType{f32, f32}, f32)
+	add(0x99, 0x9F, ResultType{f64}, f64)
+	add(0xA0, 0xA6, ResultType{f64, f64}, f64)
+
+	// Conversions
+	add(0xA7, 0xA7, ResultType{i64}, i32)
+	add(0xA8, 0xA9, ResultType{f32}, i32)
+	add(0xAA, 0xAB, ResultType{f64}, i32)
+	add(0xAC, 0xAD, ResultType{i32}, i64)
+	add(0xAE, 0xAF, ResultType{f32}, i64)
+	add(0xB0, 0xB1, ResultType{f64}, i64)
+	add(0xB2, 0xB3, ResultType{i32}, f32)
+	add(0xB4, 0xB5, ResultType{i64}, f32)
+	add(0xB6, 0xB6, ResultType{f64}, f32)
+	add(0xB7, 0xB8, ResultType{i32}, f64)
+	add(0xB9, 0xBA, ResultType{i64}, f64)
+	add(0xBB, 0xBB, ResultType{f32}, f64)
+	add(0xBC, 0xBC, ResultType{f32}, i32)
+	add(0xBD, 0xBD, ResultType{f64}, i64)
+	add(0xBE, 0xBE, ResultType{i32}, f32)
+	add(0xBF, 0xBF, ResultType{i64}, f64)
+	add(0xC0, 0xC1, ResultType{i32}, i32)
+	add(0xC2, 0xC4, ResultType{i64}, i64)
+	add(0xFC00, 0xFC01, ResultType{f32}, i32)
+	add(0xFC02, 0xFC03, ResultType{f64}, i32)
+	add(0xFC04, 0xFC05, ResultType{f32}, i64)
+	add(0xFC06, 0xFC07, ResultType{f64}, i64)
+
+	return signatures
+}()
+
+// Module-level rules
+// https://webassembly.github.io/spec/core/valid/modules.html
+
+// ValidateModule checks the rules that relate the sections of m to each other, such as
+// index ranges, limits and the uniqueness of export names. Unlike Validate it does not stop
+// at the first violation but reports all of them in section order.
+func ValidateModule(m *Module) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	report := func(id SectionId, index int, err error, format string, args ...any) {
+		diagnostics = append(diagnostics, &Diagnostic{id, uint32(index), fmt.Errorf("%w, %s", err, fmt.Sprintf(format, args...))})
+	}
+
+	var types []FunctionType
+	if m.TypeSection != nil {
+		types = m.TypeSection.FunctionTypes
+	}
+
+	checkLimits := func(id SectionId, index int, limits Limits, maximum uint32, unit string) {
+		if limits.Min > maximum || (limits.Max != nil && *limits.Max > maximum) {
+			report(id, index, ErrLimitsOutOfRange, "%s must be at most [%d] %s", id, maximum, unit)
+		}
+		if limits.Max != nil && limits.Min > *limits.Max {
+			report(id, index, ErrLimitsOutOfRange, "minimum [%d] is greater than maximum [%d]", limits.Min, *limits.Max)
+		}
+	}
+
+	for i, custom := range m.CustomSections {
+		if !utf8.ValidString(custom.Name) {
+			report(customSectionId, i, ErrMalformedUTF8, "custom section name %q", custom.Name)
+		}
+	}
+
+	memories := 0
+	for i, imp := range m.imports() {
+		if !utf8.ValidString(imp.module) || !utf8.ValidString(imp.name) {
+			report(importSectionId, i, ErrMalformedUTF8, "import %q %q", imp.module, imp.name)
+		}
+
+		switch desc := imp.importDescription.(type) {
+		case *importDescriptionFunc:
+			if int(desc.typeIndex) >= len(types) {
+				report(importSectionId, i, ErrUnknownType, "%d", desc.typeIndex)
+			}
+		case *importDescriptionTable:
+			checkLimits(importSectionId, i, desc.tableType.Limits, math.MaxUint32, "elements")
+		case *importDescriptionMem:
+			checkLimits(importSectionId, i, desc.memoryType.Limits, maxMemoryPages, "pages")
+
+			memories++
+			if memories > 1 {
+				report(importSectionId, i, ErrMultipleMemories, "memory [%d]", memories-1)
+			}
+		}
+	}
+
+	var typeIndices []uint32
+	if m.FunctionSection != nil {
+		typeIndices = m.FunctionSection.typeIndices
+	}
+
+	for i, x := range typeIndices {
+		if int(x) >= len(types) {
+			report(functionSectionId, i, ErrUnknownType, "%d", x)
+		}
+	}
+
+	var code []functionCode
+	if m.CodeSection != nil {
+		code = m.CodeSection.functionCode
+	}
+
+	// https://webassembly.github.io/spec/core/binary/modules.html#binary-module
+	if len(typeIndices) != len(code) {
+		report(codeSectionId, min(len(typeIndices), len(code)), ErrFunctionCodeMismatch, "[%d] functions, [%d] bodies", len(typeIndices), len(code))
+	}
+
+	if m.TableSection != nil {
+		for i, table := range m.TableSection.tables {
+			checkLimits(tableSectionId, i, table.Limits, math.MaxUint32, "elements")
+		}
+	}
+
+	if m.MemorySection != nil {
+		for i, memory := range m.MemorySection.memories {
+			checkLimits(memorySectionId, i, memory.Limits, maxMemoryPages, "pages")
+
+			memories++
+			if memories > 1 {
+				report(memorySectionId, i, ErrMultipleMemories, "memory [%d]", memories-1)
+			}
+		}
+	}
+
+	if m.ExportSection != nil {
+		names := make(map[string]bool)
+		for i, export := range m.ExportSection.exports {
+			if !utf8.ValidString(export.name) {
+				report(exportSectionId, i, ErrMalformedUTF8, "export %q", export.name)
+			}
+
+			if names[export.name] {
+				report(exportSectionId, i, ErrDuplicateExportName, "%q", export.name)
+			}
+			names[export.name] = true
+
+			var err error
+			switch desc := export.exportDescription.(type) {
+			case *exportDescriptionFunc:
+				if _, err = m.functionTypeIndex(desc.functionIndex); err != nil {
+					err = fmt.Errorf("%w %d", ErrUnknownFunction, desc.functionIndex)
+				}
+			case *exportDescriptionTable:
+				if _, err = m.tableType(desc.tableIndex); err != nil {
+					err = fmt.Errorf("%w %d", ErrUnknownTable, desc.tableIndex)
+				}
+			case *exportDescriptionMem:
+				if _, err = m.memoryType(desc.memoryIndex); err != nil {
+					err = fmt.Errorf("%w %d", ErrUnknownMemory, desc.memoryIndex)
+				}
+			case *exportDescriptionGlobal:
+				if _, err = m.globalType(desc.globalIndex); err != nil {
+					err = fmt.Errorf("%w %d", ErrUnknownGlobal, desc.globalIndex)
+				}
+			}
+
+			if err != nil {
+				report(exportSectionId, i, err, "export %q", export.name)
+			}
+		}
+	}
+
+	if m.StartSection != nil {
+		// https://webassembly.github.io/spec/core/valid/modules.html#start-function
+		functionType, err := m.functionType(m.StartSection.start)
+		if err != nil {
+			report(startSectionId, 0, ErrUnknownFunction, "%d", m.StartSection.start)
+		} else if len(functionType.ParameterTypes) > 0 || len(functionType.ResultTypes) > 0 {
+			report(startSectionId, 0, ErrInvalidStartFunction, "function [%d] has type [%s] -> [%s]", m.StartSection.start, valueTypesString(functionType.ParameterTypes), valueTypesString(functionType.ResultTypes))
+		}
+	}
+
+	return diagnostics
+}
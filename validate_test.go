@@ -0,0 +1,180 @@
+package jwasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatingModule(t *testing.T) {
+	module := assemble(t, `(module
+  (import "env" "f" (func (param i32)))
+  (memory 1)
+  (global (mut f32) (f32.const 0))
+  (func (param i32) (result i32)
+    (local.get 0)
+    (block (param i32) (result i32)
+      (br_if 0 (i32.const 1))
+      (i32.load8_s offset=2))
+    (call 0 (i32.const 0))
+    (global.set 0 (f32.demote_f64 (f64.const 1)))))`)
+
+	assert.NoError(t, Validate(module))
+}
+
+func TestValidatingReportsInstructionOffset(t *testing.T) {
+	module := assemble(t, `(module
+  (func)
+  (func (result i32)
+    i32.const 300
+    block
+      nop
+      i64.const 0
+      i32.add
+      drop
+    end
+    ))`)
+
+	err := Validate(module)
+
+	var validationError *ValidationError
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Equal(t, uint32(1), *validationError.FunctionIndex)
+		assert.Equal(t, codeSectionId, *validationError.SectionId)
+		// i32.const 300 takes 3 bytes, block 2 bytes, nop and i64.const 0 2 bytes each
+		assert.Equal(t, int64(8), validationError.Offset)
+		assert.Equal(t, "validation failed at code section, function [1], offset [0x8]: type mismatch, expected [i32], got [i64]", err.Error())
+	}
+}
+
+func TestValidatingReportsUnbalancedBlocks(t *testing.T) {
+	module := assemble(t, `(func (block (result i32) (i32.const 0) (i32.const 1)) (drop))`)
+
+	err := Validate(module)
+
+	var validationError *ValidationError
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+		// The error is reported at the end of the block
+		assert.Equal(t, int64(6), validationError.Offset)
+	}
+}
+
+func TestValidatingConstantExpressions(t *testing.T) {
+	module := assemble(t, `(module
+  (global i32 (i32.const 0))
+  (global i64 (i32.const 0)))`)
+
+	err := Validate(module)
+
+	var validationError *ValidationError
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Equal(t, globalSectionId, *validationError.SectionId)
+		assert.Nil(t, validationError.FunctionIndex)
+		assert.Contains(t, err.Error(), "global [1]: type mismatch")
+	}
+}
+
+func TestValidatingCoversOpcodeTable(t *testing.T) {
+	module := assemble(t, `(module
+  (memory 1)
+  (table 1 funcref)
+  (global (mut i32) (i32.const 0))
+  (elem func 0)
+  (data "")
+  (func (local i32) (data.drop 0)))`)
+
+	c := newValidationContext(module)
+	code := module.CodeSection.functionCode[0]
+
+	for op, name := range opcodeNames {
+		// Only float constants need more immediate bytes than supplied
+		instruction, err := newInstruction(op)
+		if err != nil {
+			continue
+		}
+
+		// Any operands are accepted on an unreachable stack
+		v := newFunctionValidator(c, &code, FunctionType{})
+		v.setUnreachable()
+
+		assert.NotErrorIs(t, v.instruction(instruction), ErrUnknownOpcode, name)
+	}
+}
+
+func TestValidatingModuleReportsAllDiagnostics(t *testing.T) {
+	module := assemble(t, `(module
+  (func $f (param i32))
+  (memory 2 1)
+  (memory 65537)
+  (export "f" (func $f))
+  (export "f" (memory 0))
+  (export "g" (global 0))
+  (start $f))`)
+	module.FunctionSection.typeIndices = append(module.FunctionSection.typeIndices, 7)
+	module.ExportSection.exports[2].name = "\xff"
+
+	diagnostics := ValidateModule(module)
+
+	expected := []struct {
+		id    SectionId
+		index uint32
+		err   error
+	}{
+		{functionSectionId, 1, ErrUnknownType},
+		{codeSectionId, 1, ErrFunctionCodeMismatch},
+		{memorySectionId, 0, ErrLimitsOutOfRange},
+		{memorySectionId, 1, ErrLimitsOutOfRange},
+		{memorySectionId, 1, ErrMultipleMemories},
+		{exportSectionId, 1, ErrDuplicateExportName},
+		{exportSectionId, 2, ErrMalformedUTF8},
+		{exportSectionId, 2, ErrUnknownGlobal},
+		{startSectionId, 0, ErrInvalidStartFunction},
+	}
+
+	if assert.Len(t, diagnostics, len(expected)) {
+		for i, e := range expected {
+			assert.Equal(t, e.id, diagnostics[i].SectionId, diagnostics[i].Error())
+			assert.Equal(t, e.index, diagnostics[i].Index, diagnostics[i].Error())
+			assert.ErrorIs(t, diagnostics[i], e.err)
+		}
+	}
+
+	assert.Equal(t, diagnostics[0], Validate(module))
+	assert.Equal(t, "validation failed at start section, entry [0]: start function, function [0] has type [i32] -> []", diagnostics[8].Error())
+}
+
+func TestValidatingLimitsNesting(t *testing.T) {
+	module := assemble(t, `(func)`)
+
+	nested := func(depth int) []instruction {
+		var body []instruction
+		for i := 0; i < depth; i++ {
+			body = []instruction{&block{&blockTypeEmpty{}, body}}
+		}
+		return body
+	}
+
+	module.CodeSection.functionCode[0].body = nested(maxNestingDepth)
+	assert.NoError(t, Validate(module))
+
+	module.CodeSection.functionCode[0].body = nested(1000000)
+	err := Validate(module)
+
+	var validationError *ValidationError
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.ErrorIs(t, err, ErrNestingTooDeep)
+	}
+}
+
+func TestValidatingLimitsLocals(t *testing.T) {
+	module := assemble(t, `(func (param i32) (local i64))`)
+
+	module.CodeSection.functionCode[0].locals = []locals{{maxLocals - 1, ValueTypeI64}}
+	assert.NoError(t, Validate(module))
+
+	module.CodeSection.functionCode[0].locals = []locals{{0xFFFFFFF0, ValueTypeI64}}
+	assert.ErrorIs(t, Validate(module), ErrTooManyLocals)
+}
@@ -3,6 +3,7 @@ package jwasm
 import (
 	"fmt"
 	"io"
+	"unicode/utf8"
 )
 
 func parseName(r io.Reader) (string, error) {
@@ -15,16 +16,29 @@ func parseName(r io.Reader) (string, error) {
 		return "", fmt.Errorf("reading vector size failed: %w", err)
 	}
 
-	bytes := make([]byte, size)
-	bytesRead, err := r.Read(bytes)
+	bytes, err := readBytes(r, size)
+	if err != nil {
+		return "", fmt.Errorf("reading vector data failed: %w", err)
+	}
 
-	if bytesRead != int(size) {
-		return "", fmt.Errorf("reading vector failed, wanted [%d] bytes, got only [%d] bytes", size, bytesRead)
+	if !utf8.Valid(bytes) {
+		return "", fmt.Errorf("%w, name %q", ErrMalformedUTF8, bytes)
 	}
 
+	return string(bytes), nil
+}
+
+// readBytes reads exactly n bytes from r. The buffer grows with the data actually
+// read, so that a bogus size does not result in a huge allocation up front.
+func readBytes(r io.Reader, n uint32) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, int64(n)))
 	if err != nil {
-		return "", fmt.Errorf("reading vector data failed: %w", err)
+		return nil, err
 	}
 
-	return string(bytes), nil
+	if len(data) != int(n) {
+		return nil, fmt.Errorf("wanted [%d] bytes, got only [%d] bytes: %w", n, len(data), io.ErrUnexpectedEOF)
+	}
+
+	return data, nil
 }